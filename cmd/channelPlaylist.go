@@ -0,0 +1,65 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// channelPlaylistCmd represents the channel playlists command
+var channelPlaylistCmd = &cobra.Command{
+	Use:     "playlists <@handle|channel-id|url>",
+	Aliases: []string{"p", "pl", "playlist"},
+	Short:   "Get YT Channel Playlists",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		page := getTabPublic()
+		isPlaylist := new(lib.IsPlaylist).
+			New(
+				page,
+				lib.YT_ChannelUrl(args[0])+lib.YT_ChTabPlaylists,
+				global.Flag.ScrollMax,
+				&global.FlagPlaylist.Exclude,
+				&global.FlagPlaylist.Include)
+		isPlaylist.Stat = &elementStat
+		if !global.FlagPlaylist.GetList {
+			isPlaylist.Stream = outputStream(is.PrintMatched)
+		}
+		isPlaylist.Run()
+		processPlaylist(isPlaylist, page)
+	},
+}
+
+func init() {
+	cmd := channelPlaylistCmd
+	channelCmd.AddCommand(cmd)
+
+	cmd.Flags().BoolVarP(&global.FlagPlaylist.GetList, "get-list", "g", false, "Get individual playlist")
+	cmd.Flags().StringArrayVarP(&global.FlagPlaylist.Exclude, "exclude", "e", []string{}, "Exclude play list containing string (Override Include)")
+	cmd.Flags().StringArrayVarP(&global.FlagPlaylist.Include, "include", "i", []string{}, "Include play list containing string")
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson, lib.OutputYtdlp, lib.OutputM3u, lib.OutputM3u8)
+	flagExec(cmd)
+}
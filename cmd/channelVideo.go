@@ -0,0 +1,99 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// channelVideoCmd represents the channel videos command
+var channelVideoCmd = &cobra.Command{
+	Use:     "videos <@handle|channel-id|url>",
+	Aliases: []string{"v", "video"},
+	Short:   "Get YT Channel Videos",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		processChannelVideo(args[0], lib.YT_ChTabVideos)
+	},
+}
+
+// channelShortsCmd represents the channel shorts command
+var channelShortsCmd = &cobra.Command{
+	Use:     "shorts <@handle|channel-id|url>",
+	Aliases: []string{"s", "short"},
+	Short:   "Get YT Channel Shorts",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		processChannelVideo(args[0], lib.YT_ChTabShorts)
+	},
+}
+
+// channelLiveCmd represents the channel live command
+var channelLiveCmd = &cobra.Command{
+	Use:     "live <@handle|channel-id|url>",
+	Aliases: []string{"l", "streams"},
+	Short:   "Get YT Channel Live Streams",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		processChannelVideo(args[0], lib.YT_ChTabLive)
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{channelVideoCmd, channelShortsCmd, channelLiveCmd} {
+		channelCmd.AddCommand(cmd)
+		cmd.Flags().UintVarP(&global.FlagChannel.Day, "day", "", 0, "number of days (override scroll)")
+		flagShorts(cmd.Flags(), lib.ShortsInclude)
+		flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson, lib.OutputAtom, lib.OutputYtdlp, lib.OutputM3u, lib.OutputM3u8)
+		flagExec(cmd)
+	}
+}
+
+func processChannelVideo(ch string, tab string) {
+	if err := lib.ShortsCheck(global.Flag.Shorts); err != nil {
+		errs.Queue("channel video", err)
+		return
+	}
+	page := getTabPublic()
+
+	urlStr := lib.YT_ChannelUrl(ch) + tab
+	isChannelVideo := new(lib.IsChannelVideo).
+		New(
+			page,
+			urlStr,
+			global.Flag.ScrollMax,
+			global.FlagChannel.Day,
+		)
+	isChannelVideo.Resolver = &chResolver
+	isChannelVideo.Shorts = global.Flag.Shorts
+	isChannelVideo.Stat = &elementStat
+	isChannelVideo.Stream = outputStream(is.PrintMatched)
+	isChannelVideo.Run()
+	if isChannelVideo.Err == nil {
+		printList(isChannelVideo.IInfoList, is.PrintMatched, isChannelVideo.Channel.ChTitle, urlStr)
+	}
+}
@@ -23,7 +23,13 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
 	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
 	"github.com/J-Siu/yt-toolbox/v2/global"
 	"github.com/spf13/cobra"
 )
@@ -33,12 +39,52 @@ var configCmd = &cobra.Command{
 	Use:     "config",
 	Aliases: []string{"c", "conf"},
 	Short:   "Print configurations",
+	Long: `Print configurations.
+
+Precedence: flag > env > config (profile over top level) > default.
+Env is YTTB_ and key in upper snake case, eg. YTTB_DEVTOOLS_PORT, YTTB_OVERLAY_CONSENT, YTTB_SCROLL_MAX for --scroll-max.
+List in env is comma separated, eg. YTTB_HISTORY_FILTER=a,b.
+
+--explain shows source of each value.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if global.FlagConfig.Explain {
+			ezlog.Log().M(configExplain(&global.Conf)).Out()
+			return
+		}
 		ezlog.Log().N("Config").Lm(&global.Conf).Out()
 	},
 }
 
+// Return table of config values with env name and source
+func configExplain(c *conf.TypeConf) string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Key\tValue\tSource\tEnv")
+	for _, field := range c.Fields() {
+		value, _ := json.Marshal(field.Value.Interface())
+		env := field.Env
+		if len(env) == 0 {
+			env = "-"
+		}
+		fmt.Fprintln(w, field.Path+"\t"+string(value)+"\t"+c.SourceOf(field.Path)+"\t"+env)
+	}
+	w.Flush()
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Return true if [cmd] is config or its sub command
+func isConfigCmd(cmd *cobra.Command) bool {
+	for ; cmd != nil; cmd = cmd.Parent() {
+		if cmd == configCmd {
+			return true
+		}
+	}
+	return false
+}
+
 func init() {
 	cmd := configCmd
 	rootCmd.AddCommand(cmd)
+
+	cmd.Flags().BoolVarP(&global.FlagConfig.Explain, "explain", "", false, "Show source and env of each value")
 }
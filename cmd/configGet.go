@@ -0,0 +1,81 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/spf13/cobra"
+)
+
+// configGetCmd represents the config get command
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print config value",
+	Long: `Print config value after default, config file and profile are applied.
+
+Key is dot separated and case insensitive, eg. DevtoolsPort, Overlay.Consent, Daemon.Jobs.0.Name.
+Map and list are printed in json.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "config get"
+		var (
+			m   map[string]any
+			out string
+		)
+		data, err := json.Marshal(&global.Conf)
+		if err == nil {
+			err = json.Unmarshal(data, &m)
+		}
+		if err == nil {
+			value, ok := conf.Lookup(m, conf.KeyPath(args[0]))
+			switch value.(type) {
+			case nil:
+			case map[string]any, []any:
+				data, err = json.MarshalIndent(value, "", "  ")
+				out = string(data)
+			default:
+				out = fmt.Sprint(value)
+			}
+			if !ok {
+				err = errors.New("key not found: " + args[0])
+			}
+		}
+		if err != nil {
+			errs.Queue(prefix, err)
+			return
+		}
+		ezlog.Log().M(out).Out()
+	},
+}
+
+func init() {
+	cmd := configGetCmd
+	configCmd.AddCommand(cmd)
+}
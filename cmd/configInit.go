@@ -0,0 +1,80 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/spf13/cobra"
+)
+
+// configInitCmd represents the config init command
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write starter config file",
+	Long: `Write starter config file, format by extension of config file.
+
+Yaml and toml starter files are commented.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "config init"
+		var (
+			err      error
+			fileType string
+		)
+		path := global.Conf.FileConf
+		if len(global.FlagConfig.Format) > 0 {
+			path = strings.TrimSuffix(path, filepath.Ext(path)) + "." + strings.ToLower(global.FlagConfig.Format)
+		}
+		if fileType, err = conf.FileType(path); err == nil {
+			if _, e := os.Stat(path); e == nil && !global.FlagConfig.Force {
+				err = errors.New(path + " exists, use --force to overwrite")
+			}
+		}
+		if err == nil {
+			err = os.MkdirAll(filepath.Dir(path), 0700)
+		}
+		if err == nil {
+			err = os.WriteFile(path, []byte(conf.Starter(fileType)), 0600)
+		}
+		if err != nil {
+			errs.Queue(prefix, err)
+			return
+		}
+		ezlog.Log().N("Created").M(path).Out()
+	},
+}
+
+func init() {
+	cmd := configInitCmd
+	configCmd.AddCommand(cmd)
+
+	cmd.Flags().BoolVarP(&global.FlagConfig.Force, "force", "f", false, "Overwrite existing config file")
+	cmd.Flags().StringVarP(&global.FlagConfig.Format, "format", "", "", "Config file format: json, yaml, toml (default: extension of config file)")
+}
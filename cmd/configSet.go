@@ -0,0 +1,56 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/spf13/cobra"
+)
+
+// configSetCmd represents the config set command
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set config value in config file",
+	Long: `Set config value in config file. File is created if not exists.
+
+Key is dot separated and case insensitive, eg. DevtoolsPort, Overlay.Consent, Profiles.work.DevtoolsPort.
+Value is parsed as yaml, eg. 9222, true, "[a, b]".
+File is validated before written. Comments in file are not kept.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "config set"
+		if err := conf.FileSet(global.Conf.FileConf, args[0], args[1]); err != nil {
+			errs.Queue(prefix, err)
+			return
+		}
+		ezlog.Log().N("Set").N(args[0]).M(args[1]).Out()
+	},
+}
+
+func init() {
+	cmd := configSetCmd
+	configCmd.AddCommand(cmd)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/J-Siu/go-dtquery/dq"
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// daemonCmd represents the daemon command
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Run scheduled jobs from config",
+	Long: `Run jobs in config "Daemon.Jobs" on cron schedule, until SIGINT/SIGTERM.
+Each job runs yt-toolbox with its "Args", or named job "Job" in config "Jobs", in a child process, using the running browser.
+Jobs using the same devtools host and port are run one at a time.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "daemon"
+		var jobs []*lib.SchedJob
+		for _, job := range global.Conf.Daemon.Jobs {
+			schedJob, err := daemonJob(job)
+			if err != nil {
+				errs.Queue(prefix, errors.New(job.Name+": "+err.Error()))
+			}
+			jobs = append(jobs, schedJob)
+		}
+		if len(jobs) == 0 {
+			errs.Queue(prefix, errors.New("no job in config Daemon.Jobs"))
+		}
+		if errs.NotEmpty() {
+			return
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		lib.LogTime(true)
+		new(lib.Scheduler).New(jobs).Run(ctx)
+	},
+}
+
+func init() {
+	cmd := daemonCmd
+	rootCmd.AddCommand(cmd)
+}
+
+func daemonJob(job conf.TypeDaemonJob) (schedJob *lib.SchedJob, err error) {
+	var (
+		browser    = global.Conf.Browser
+		dirBrowser = global.Conf.DirBrowser
+		host       = global.Conf.DevtoolsHost
+		port       = global.Conf.DevtoolsPort
+		sink       string
+	)
+	if len(job.Job) > 0 {
+		if len(job.Args) > 0 {
+			return nil, errors.New("both Args and Job")
+		}
+		if job.Args, sink, err = jobArgs(job.Job); err != nil {
+			return nil, err
+		}
+		if profile, ok := global.Conf.Jobs[strings.ToLower(job.Job)]["profile"].(string); ok && len(job.Profile) == 0 {
+			job.Profile = profile
+		}
+	}
+	if len(job.Profile) > 0 {
+		profileConf := global.Conf
+		profileConf.Source = maps.Clone(global.Conf.Source)
+		if err = profileConf.UseProfile(job.Profile); err != nil {
+			return nil, err
+		}
+		browser = profileConf.Browser
+		dirBrowser = profileConf.DirBrowser
+		host = profileConf.DevtoolsHost
+		port = profileConf.DevtoolsPort
+	}
+	if len(job.DevtoolsHost) > 0 {
+		host = job.DevtoolsHost
+	}
+	if job.DevtoolsPort > 0 {
+		port = job.DevtoolsPort
+	}
+	if len(job.Args) == 0 {
+		return nil, errors.New("empty Args")
+	}
+	schedJob = &lib.SchedJob{
+		Name: job.Name,
+		Key:  host + ":" + strconv.Itoa(port),
+		Run: func(ctx context.Context) error {
+			// browser is launched again if it is down, as for any command, else it must be up
+			if len(dirBrowser) > 0 {
+				if err := lib.LaunchBrowser(browser, dirBrowser, host, port); err != nil {
+					return err
+				}
+			} else if devtools := dq.Get(host, port); devtools.Err != nil {
+				return devtools.Err
+			}
+			args := append([]string{}, job.Args...)
+			if len(job.Profile) > 0 {
+				args = append(args, "--profile", job.Profile)
+			}
+			args = append(args, "--host", host, "--port", strconv.Itoa(port))
+			// output is logged without sink
+			if len(sink) == 0 {
+				return runSelf(ctx, job.Name, args, nil)
+			}
+			w, closeSink, err := jobSink(sink, job.Job, time.Now())
+			if err == nil {
+				err = errors.Join(runSelf(ctx, job.Name, args, w), closeSink())
+			}
+			return err
+		},
+	}
+	_, err = schedJob.Cron.New(job.Schedule)
+	return schedJob, err
+}
@@ -0,0 +1,101 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"sort"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// exportFreetubeCmd represents the export freetube command
+var exportFreetubeCmd = &cobra.Command{
+	Use:     "freetube",
+	Aliases: []string{"ft"},
+	Short:   "Export subscriptions or playlists as FreeTube db",
+	Long: `Export subscriptions as FreeTube profiles.db, or playlists as FreeTube playlists.db with --playlists, to stdout.
+
+Channels without channel id are skipped.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "export freetube"
+		var (
+			b   []byte
+			err error
+		)
+		page := getTab()
+		if global.FlagExport.Playlists {
+			isPlaylist := new(lib.IsPlaylist).
+				New(
+					page,
+					lib.YT_Playlists,
+					global.Flag.ScrollMax,
+					&global.FlagPlaylist.Exclude,
+					&global.FlagPlaylist.Include)
+			isPlaylist.Stat = &elementStat
+			isPlaylist.Run()
+			if isPlaylist.Err != nil {
+				return
+			}
+			sort.Sort(isPlaylist.IInfoList)
+			var playlists []lib.PlaylistVideos
+			for _, info := range *isPlaylist.IInfoList {
+				if info.Matched() && !elementStat.Stopped() {
+					isVideoList := videoList(info, page, nil)
+					if isVideoList.Err == nil {
+						playlists = append(playlists, lib.PlaylistVideos{Playlist: info.(*lib.YT_Info), Videos: isVideoList.IInfoList})
+					}
+				}
+			}
+			b, err = lib.FreeTubeExportPlaylists(playlists)
+		} else {
+			isSubCh := subChannel(page, nil)
+			if isSubCh.Err != nil {
+				return
+			}
+			var skipped *is.IInfoList
+			b, skipped, err = lib.FreeTubeExportSubscriptions(isSubCh.IInfoList)
+			for _, info := range *skipped {
+				ezlog.Err().N(prefix).N("no channel id").M(info.(*lib.YT_Info).ChTitle).Out()
+			}
+		}
+		if err == nil {
+			ezlog.Log().M(string(b)).Out()
+		} else {
+			errs.Queue(prefix, err)
+		}
+	},
+}
+
+func init() {
+	cmd := exportFreetubeCmd
+	exportCmd.AddCommand(cmd)
+
+	cmd.Flags().BoolVarP(&global.FlagExport.Playlists, "playlists", "p", false, "Export playlists instead of subscriptions")
+	cmd.Flags().StringArrayVarP(&global.FlagPlaylist.Exclude, "exclude", "e", []string{}, "Exclude play list containing string (Override Include)")
+	cmd.Flags().StringArrayVarP(&global.FlagPlaylist.Include, "include", "i", []string{}, "Include play list containing string")
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// exportNewpipeCmd represents the export newpipe command
+var exportNewpipeCmd = &cobra.Command{
+	Use:     "newpipe",
+	Aliases: []string{"np"},
+	Short:   "Export subscriptions as NewPipe subscriptions.json",
+	Long: `Export subscriptions as NewPipe subscriptions.json to stdout.
+
+NewPipe playlists are only in NewPipe database backup, and are not supported.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "export newpipe"
+		isSubCh := subChannel(getTab(), nil)
+		if isSubCh.Err == nil {
+			b, err := lib.NewPipeExport(isSubCh.IInfoList)
+			if err == nil {
+				ezlog.Log().M(string(b)).Out()
+			} else {
+				errs.Queue(prefix, err)
+			}
+		}
+	},
+}
+
+func init() {
+	cmd := exportNewpipeCmd
+	exportCmd.AddCommand(cmd)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/runZeroInc/go-rod"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Shared by all commands, init in root PersistentPreRun
+var chResolver lib.ChResolver
+
+// Element extraction errors and stop signal of all processors, checked in root PersistentPostRun
+var elementStat lib.ElementStat
+
+// Items written with --output ndjson, shared by processors and printList for deduplication
+var listStream lib.Stream
+
+// Overlays dismissed on tab, shared by processors with elementStat
+var overlay lib.Dismisser
+
+// Get tab from devtools in config, and attach it to shared helpers.
+// Exit if no tab, or tab is not a signed in YouTube page.
+func getTab() (page *rod.Page) { return tab(true) }
+
+// Same as [getTab], but signed out is allowed. For public pages.
+func getTabPublic() (page *rod.Page) { return tab(false) }
+
+func tab(signedIn bool) (page *rod.Page) {
+	var err error
+	if len(global.Conf.DirBrowser) > 0 {
+		err = lib.LaunchBrowser(global.Conf.Browser, global.Conf.DirBrowser, global.Conf.DevtoolsHost, global.Conf.DevtoolsPort)
+	}
+	if err == nil {
+		page, err = lib.GetTab(global.Conf.DevtoolsHost, global.Conf.DevtoolsPort)
+	}
+	if err == nil {
+		overlay.Page = page
+		if err = lib.Preflight(page, &overlay); !signedIn && errors.Is(err, lib.ErrSignedOut) {
+			ezlog.Debug().M(err).Out()
+			err = nil
+		}
+	}
+	if err != nil {
+		ezlog.Err().M(err).Out()
+		switch {
+		case errors.Is(err, lib.ErrSignedOut):
+			os.Exit(ExitSignedOut)
+		case errors.Is(err, lib.ErrConsent):
+			os.Exit(ExitConsent)
+		case errors.Is(err, lib.ErrCaptcha):
+			os.Exit(ExitCaptcha)
+		}
+		os.Exit(1)
+	}
+	chResolver.Page = page
+	if len(global.Flag.As) > 0 {
+		accountUse(page, global.Flag.As)
+	}
+	return page
+}
+
+// Switch to channel [target]. Exit if switch failed or cannot be verified.
+func accountUse(page *rod.Page, target string) *lib.Account {
+	account := new(lib.Account).New(page)
+	account.Resolver = &chResolver
+	if account.Use(target).Err != nil {
+		ezlog.Err().M(account.Err).Out()
+		os.Exit(1)
+	}
+	return account
+}
+
+// Add --output flag to [cmd] with supported [formats], first one is default
+func flagOutput(cmd *cobra.Command, formats ...string) {
+	cmd.Flags().StringVarP(&global.Flag.Output, "output", "o", formats[0], "Output format: "+strings.Join(formats, ", "))
+	cmd.Annotations = map[string]string{"output": strings.Join(formats, ",")}
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) (err error) {
+		global.Flag.Output, err = outputFormat(cmd)
+		return err
+	}
+}
+
+// Output format of [cmd]: --output, or config Output if supported by [cmd], or default
+func outputFormat(cmd *cobra.Command) (string, error) {
+	formats := strings.Split(cmd.Annotations["output"], ",")
+	if !cmd.Flags().Changed("output") && slices.Contains(formats, global.Conf.Output) {
+		return global.Conf.Output, nil
+	}
+	if !slices.Contains(formats, global.Flag.Output) {
+		return "", errors.New("unknown output: " + global.Flag.Output)
+	}
+	return global.Flag.Output, nil
+}
+
+// Stream for processor output selected by [mode] with --output ndjson, nil otherwise or with --exec
+func outputStream(mode is.IInfoListPrintMode) *lib.Stream {
+	if global.Flag.Output != lib.OutputNdjson || len(global.Flag.Exec) > 0 {
+		return nil
+	}
+	if !listStream.Initialized {
+		listStream.New(elementStat.Progress.Writer(os.Stdout), mode)
+	}
+	listStream.Mode = mode
+	return &listStream
+}
+
+// Add --exec and --exec-jobs flags to [cmd]
+func flagExec(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&global.Flag.Exec, "exec", "x", "", "Run command for each item instead of output, without shell. Placeholders: {url}, {title}, {channel}, {channel_id}, {channel_url}, {id}")
+	cmd.Flags().IntVarP(&global.Flag.ExecJobs, "exec-jobs", "j", 1, "Number of --exec run in parallel")
+}
+
+// Add --shorts flag to [cmd]
+func flagShorts(flags *pflag.FlagSet, policy string) {
+	flags.StringVarP(&global.Flag.Shorts, "shorts", "", policy, "Shorts: "+strings.Join([]string{lib.ShortsInclude, lib.ShortsExclude, lib.ShortsOnly}, ", "))
+}
+
+// Log flagged and skipped elements of [stat]. Return error if they are more than --max-errors.
+func elementReport(stat *lib.ElementStat) error {
+	if stat.Total() == 0 {
+		return nil
+	}
+	ezlog.Err().N("Elements").N("flagged").M(stat.Flagged).N("skipped").M(stat.Skipped).Out()
+	for _, item := range stat.Items {
+		ezlog.Err().M(item).Out()
+	}
+	if stat.Total() > global.Flag.MaxErrors {
+		return errors.New("element errors " + strconv.Itoa(stat.Total()) + " > --max-errors " + strconv.Itoa(global.Flag.MaxErrors))
+	}
+	return nil
+}
+
+// Return true if list of command with own md print, eg. history, is printed by [printList]
+func listOutput() bool {
+	return global.Flag.Output != lib.OutputMd || len(global.Flag.Exec) > 0
+}
+
+// Print [list] in --output format, or run --exec for each item. [title] and [urlStr] are used by atom.
+func printList(list *is.IInfoList, mode is.IInfoListPrintMode, title, urlStr string) {
+	prefix := "printList"
+	if len(global.Flag.Exec) > 0 && elementStat.Interrupted {
+		errs.Queue(prefix, errors.New("interrupted, --exec skipped"))
+		return
+	}
+	if len(global.Flag.Exec) > 0 {
+		// command output is not through progress
+		elementStat.Progress.Close()
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		exec := new(lib.Exec).New(global.Flag.Exec, global.Flag.ExecJobs)
+		if exec.Err == nil {
+			exec.Run(ctx, lib.ListFilter(list, mode)).Print()
+			if exec.Failed() > 0 {
+				exec.Err = errors.New(strconv.Itoa(exec.Failed()) + " of " + strconv.Itoa(len(exec.Results)) + " exec failed")
+			}
+		}
+		if exec.Err != nil {
+			errs.Queue(prefix, exec.Err)
+		}
+		return
+	}
+	if global.Flag.Output == lib.OutputNdjson {
+		// items not already written by processors
+		if err := outputStream(mode).Add(list).Err; err != nil {
+			errs.Queue(prefix, err)
+		}
+		return
+	}
+	if err := lib.ListPrint(lib.ListDesc(list, global.Flag.Desc), mode, global.Flag.Output, title, urlStr); err != nil {
+		errs.Queue(prefix, err)
+	}
+}
@@ -23,19 +23,35 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-is/v3/is"
 	"github.com/J-Siu/yt-toolbox/v2/global"
 	"github.com/J-Siu/yt-toolbox/v2/lib"
 	"github.com/spf13/cobra"
 )
 
+// Atom feed title
+const historyTitle = "YouTube History"
+
 // historyCmd represents the history command
 var historyCmd = &cobra.Command{
 	Use:     "history",
 	Aliases: []string{"h", "hist"},
 	Short:   "Get Youtube History",
 	Run: func(cmd *cobra.Command, args []string) {
-		page := lib.GetTab(global.Conf.DevtoolsHost, global.Conf.DevtoolsPort)
+		if err := lib.ShortsCheck(global.Flag.Shorts); err != nil {
+			errs.Queue("history", err)
+			return
+		}
+		page := getTab()
 
+		var (
+			entries is.IInfoList
+			mode    = is.PrintMatched
+		)
+		if global.Flag.Verbose {
+			mode = is.PrintAll
+		}
 		isHistorySection := new(lib.IsHistorySection).
 			New(
 				page,
@@ -44,9 +60,23 @@ var historyCmd = &cobra.Command{
 				global.Flag.ScrollMax,
 				global.Flag.Verbose)
 		isHistorySection.Del = global.FlagHistory.Del
+		isHistorySection.Desc = global.Flag.Desc
 		isHistorySection.Filter = append(isHistorySection.Filter, global.Conf.HistoryFilter...)
+		isHistorySection.Resolver = &chResolver
+		isHistorySection.Shorts = global.Flag.Shorts
+		isHistorySection.Stat = &elementStat
+		if listOutput() {
+			// md output is printed by section
+			isHistorySection.Entries = &entries
+			isHistorySection.NoPrint = true
+			isHistorySection.PrintHeader = false
+			isHistorySection.Stream = outputStream(mode)
+		}
 		isHistorySection.
 			Run()
+		if listOutput() && isHistorySection.Err == nil {
+			printList(&entries, mode, historyTitle, lib.YT_History)
+		}
 	},
 }
 
@@ -55,5 +85,8 @@ func init() {
 	rootCmd.AddCommand(cmd)
 
 	cmd.PersistentFlags().BoolVarP(&global.FlagHistory.Del, "del", "", false, "Perform actual deletion. [default: Dry run]")
+	flagShorts(cmd.PersistentFlags(), lib.ShortsExclude) // shorts are not deleted unless asked
 	cmd.PersistentFlags().BoolVarP(&global.FlagHistory.NoRemove, "no-remove", "n", false, "No removal of screen element. (Not history deletion!) [default: Remove screen element.]")
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson, lib.OutputYtdlp, lib.OutputM3u, lib.OutputM3u8)
+	flagExec(cmd)
 }
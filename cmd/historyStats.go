@@ -0,0 +1,82 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// historyStatsCmd represents the history stats command
+var historyStatsCmd = &cobra.Command{
+	Use:     "stats",
+	Aliases: []string{"s", "stat"},
+	Short:   "Aggregate Youtube History by channel, weekday and date",
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "history stats"
+		if err := lib.ShortsCheck(global.Flag.Shorts); err != nil {
+			errs.Queue(prefix, err)
+			return
+		}
+		page := getTab()
+
+		var entries is.IInfoList
+		isHistorySection := new(lib.IsHistorySection).
+			New(
+				page,
+				lib.YT_History,
+				!global.FlagHistory.NoRemove,
+				global.Flag.ScrollMax,
+				false)
+		isHistorySection.Entries = &entries
+		isHistorySection.NoPrint = true
+		isHistorySection.PrintHeader = false
+		isHistorySection.Resolver = &chResolver
+		isHistorySection.Shorts = global.Flag.Shorts
+		isHistorySection.Stat = &elementStat
+		isHistorySection.Run()
+		if isHistorySection.Err == nil {
+			stats := new(lib.HistoryStats).New(&entries, time.Now())
+			if global.Flag.Output == lib.OutputJson {
+				stats.PrintJson()
+			} else {
+				stats.Print()
+			}
+			if stats.Err != nil {
+				errs.Queue(prefix, stats.Err)
+			}
+		}
+	},
+}
+
+func init() {
+	cmd := historyStatsCmd
+	historyCmd.AddCommand(cmd)
+
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson)
+}
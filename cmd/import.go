@@ -0,0 +1,113 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-helper/v2/file"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Read NewPipe or FreeTube subscriptions/playlists",
+	Long: `Read NewPipe subscriptions.json, FreeTube profiles.db or playlists.db, and print subscriptions and playlist videos.
+
+With --diff, channels already subscribed in YouTube account are marked [X].`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "import"
+		var (
+			channels  *is.IInfoList
+			playlists []lib.PlaylistVideos
+		)
+		b, err := file.ReadByte(file.TildeEnvExpand(args[0]))
+		if err == nil {
+			if lib.NewPipeIs(*b) {
+				channels, err = lib.NewPipeImport(*b)
+			} else {
+				channels, playlists, err = lib.FreeTubeImport(*b)
+			}
+		}
+		if err != nil {
+			errs.Queue(prefix, err)
+			return
+		}
+		if global.FlagImport.Diff && len(*channels) > 0 {
+			isSubCh := subChannel(getTab(), nil)
+			if isSubCh.Err != nil {
+				return
+			}
+			importDiff(channels, isSubCh.IInfoList)
+		}
+		if global.Flag.Output == lib.OutputMd {
+			if len(*channels) > 0 {
+				ezlog.Log().N("Subscriptions").Out()
+				lib.ListDesc(channels, global.Flag.Desc).Print(is.PrintAll)
+			}
+			for _, pv := range playlists {
+				ezlog.Log().N(pv.Playlist.Title).Out()
+				lib.ListDesc(pv.Videos, global.Flag.Desc).Print(is.PrintAll)
+			}
+			return
+		}
+		// Other output: subscriptions, then videos of all playlists
+		list := *channels
+		for _, pv := range playlists {
+			list = append(list, *pv.Videos...)
+		}
+		printList(&list, is.PrintAll, "Import", "")
+	},
+}
+
+func init() {
+	cmd := importCmd
+	rootCmd.AddCommand(cmd)
+
+	cmd.Flags().BoolVarP(&global.FlagImport.Diff, "diff", "", false, "Mark channels subscribed in YouTube account")
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson, lib.OutputYtdlp, lib.OutputM3u, lib.OutputM3u8)
+}
+
+// Mark [channels] found in [subscribed] by channel id, or url
+func importDiff(channels, subscribed *is.IInfoList) {
+	keys := make(map[string]bool)
+	for _, iinfo := range *subscribed {
+		info := iinfo.(*lib.YT_Info)
+		for _, key := range []string{info.ChId, info.ChUrlShort} {
+			if len(key) > 0 {
+				keys[strings.ToLower(key)] = true
+			}
+		}
+	}
+	for _, iinfo := range *channels {
+		info := iinfo.(*lib.YT_Info)
+		info.SetMatched(keys[strings.ToLower(info.ChId)] || keys[strings.ToLower(info.ChUrlShort)])
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+)
+
+// Time allowed for a child to exit after SIGTERM
+const jobWaitDelay = 2 * time.Minute
+
+// Run yt-toolbox with [args] as a child process, using current config file.
+//
+// Child stderr, its errors and diagnostics, is written to stderr with [name].
+// Child stdout, its output only, is written to [stdout], or logged if nil.
+// On [ctx] done, child receives SIGTERM.
+func runSelf(ctx context.Context, name string, args []string, stdout io.Writer) (err error) {
+	var (
+		exe    string
+		logs   []func() *ezlog.EzLog // log of pipes
+		mu     sync.Mutex
+		pipes  []io.ReadCloser
+		reader io.ReadCloser
+		wg     sync.WaitGroup
+	)
+	exe, err = os.Executable()
+	if err != nil {
+		return err
+	}
+	args = append(args, "--config", global.Conf.FileConf)
+	child := exec.CommandContext(ctx, exe, args...)
+	child.Cancel = func() error { return child.Process.Signal(syscall.SIGTERM) }
+	child.WaitDelay = jobWaitDelay
+	if stdout == nil {
+		if reader, err = child.StdoutPipe(); err == nil {
+			pipes = append(pipes, reader)
+			logs = append(logs, ezlog.Log)
+		}
+	} else {
+		child.Stdout = stdout
+	}
+	if err == nil {
+		if reader, err = child.StderrPipe(); err == nil {
+			pipes = append(pipes, reader)
+			logs = append(logs, lib.Diag)
+		}
+	}
+	if err == nil {
+		ezlog.Debug().N(name).N("args").M(args).Out()
+		err = child.Start()
+	}
+	if err == nil {
+		for i, pipe := range pipes {
+			wg.Add(1)
+			go func(pipe io.Reader, log func() *ezlog.EzLog) {
+				defer wg.Done()
+				scanner := bufio.NewScanner(pipe)
+				scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+				for scanner.Scan() {
+					// ezlog is not safe for concurrent use
+					mu.Lock()
+					log().N(name).M(scanner.Text()).Out()
+					mu.Unlock()
+				}
+			}(pipe, logs[i])
+		}
+		wg.Wait()
+		err = child.Wait()
+	}
+	return err
+}
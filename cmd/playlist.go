@@ -39,28 +39,20 @@ var playlistCmd = &cobra.Command{
 	Aliases: []string{"p", "pl"},
 	Short:   "Get Youtube Playlist",
 	Run: func(cmd *cobra.Command, args []string) {
-		page := lib.GetTab(global.Conf.DevtoolsHost, global.Conf.DevtoolsPort)
+		page := getTab()
 		isPlaylist := new(lib.IsPlaylist).
 			New(
 				page,
 				lib.YT_Playlists,
 				global.Flag.ScrollMax,
 				&global.FlagPlaylist.Exclude,
-				&global.FlagPlaylist.Include).
-			Run()
-
-		if isPlaylist.Err == nil {
-			ezlog.Log().N("Playlist").Out()
-			sort.Sort(isPlaylist.IInfoList)
-			isPlaylist.IInfoList.Print(is.PrintMatched)
-			if global.FlagPlaylist.GetList {
-				for _, info := range *isPlaylist.IInfoList {
-					if info.Matched() {
-						processVideoList(info, page)
-					}
-				}
-			}
+				&global.FlagPlaylist.Include)
+		isPlaylist.Stat = &elementStat
+		if !global.FlagPlaylist.GetList {
+			isPlaylist.Stream = outputStream(is.PrintMatched)
 		}
+		isPlaylist.Run()
+		processPlaylist(isPlaylist, page)
 	},
 }
 
@@ -71,17 +63,66 @@ func init() {
 	cmd.PersistentFlags().BoolVarP(&global.FlagPlaylist.GetList, "get-list", "g", false, "Get individual playlist")
 	cmd.PersistentFlags().StringArrayVarP(&global.FlagPlaylist.Exclude, "exclude", "e", []string{}, "Exclude play list containing string (Override Include)")
 	cmd.PersistentFlags().StringArrayVarP(&global.FlagPlaylist.Include, "include", "i", []string{}, "Include play list containing string")
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson, lib.OutputYtdlp, lib.OutputM3u, lib.OutputM3u8)
+	flagExec(cmd)
+}
+
+func processPlaylist(isPlaylist *lib.IsPlaylist, page *rod.Page) {
+	if isPlaylist.Err == nil {
+		sort.Sort(isPlaylist.IInfoList)
+		if global.Flag.Output != lib.OutputMd || len(global.Flag.Exec) > 0 {
+			processPlaylistAll(isPlaylist, page)
+			return
+		}
+		ezlog.Log().N("Playlist").Out()
+		lib.ListDesc(isPlaylist.IInfoList, global.Flag.Desc).Print(is.PrintMatched)
+		if global.FlagPlaylist.GetList {
+			for _, info := range *isPlaylist.IInfoList {
+				if info.Matched() && !elementStat.Stopped() {
+					processVideoList(info, page)
+				}
+			}
+		}
+	}
+}
+
+// Output matched playlists, or videos of all matched playlists in one list with --get-list
+func processPlaylistAll(isPlaylist *lib.IsPlaylist, page *rod.Page) {
+	title := "Playlists"
+	if !global.FlagPlaylist.GetList {
+		printList(isPlaylist.IInfoList, is.PrintMatched, title, isPlaylist.UrlStr)
+		return
+	}
+	var list is.IInfoList
+	for _, info := range *isPlaylist.IInfoList {
+		if info.Matched() && !elementStat.Stopped() {
+			isVideoList := videoList(info, page, outputStream(is.PrintAll))
+			if isVideoList.Err == nil {
+				list = append(list, *isVideoList.IInfoList...)
+			}
+		}
+	}
+	printList(&list, is.PrintAll, title, isPlaylist.UrlStr)
 }
 
 func processVideoList(iinfo is.IInfo, page *rod.Page) {
+	isVideoList := videoList(iinfo, page, nil)
+	ezlog.Log().N(iinfo.(*lib.YT_Info).Title).Out()
+	lib.ListDesc(isVideoList.IInfoList, global.Flag.Desc).Print(is.PrintAll)
+}
+
+// Get videos of playlist [iinfo]. Videos are also written to [stream] if not nil.
+func videoList(iinfo is.IInfo, page *rod.Page, stream *lib.Stream) *lib.IsPlaylistVideo {
 	info := iinfo.(*lib.YT_Info)
 	var isVideoList lib.IsPlaylistVideo
 	isVideoList.
 		New(
 			page,
 			info.Url,
-			global.Flag.ScrollMax).
-		Run()
-	ezlog.Log().N(info.Title).Out()
-	isVideoList.IInfoList.Print(is.PrintAll)
+			global.Flag.ScrollMax)
+	isVideoList.Resolver = &chResolver
+	isVideoList.Stat = &elementStat
+	isVideoList.Stream = stream
+	isVideoList.Run()
+	return &isVideoList
 }
@@ -0,0 +1,168 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// Run [cmd] for each profile in child process, output is labeled with profile name. Exit when done.
+//
+// Output format is the same for all profiles: --output, or top level config Output, or default.
+// Json output of all profiles is merged into one list.
+func runAllProfiles(cmd *cobra.Command) {
+	prefix := "runAllProfiles"
+	var (
+		args   = profileArgs(os.Args[1:])
+		format string
+		items  = []map[string]any{}
+		names  = global.Conf.ProfileNames()
+	)
+	if len(global.Flag.Profile) > 0 {
+		errs.Queue(prefix, errors.New("--profile cannot be used with --all-profiles"))
+	}
+	if len(names) == 0 {
+		errs.Queue(prefix, errors.New("no profile in config Profiles"))
+	}
+	if _, ok := cmd.Annotations["output"]; ok && errs.IsEmpty() {
+		var err error
+		if format, err = outputFormat(cmd); err == nil && format == lib.OutputAtom {
+			err = errors.New("output atom cannot be used with --all-profiles")
+		}
+		if err != nil {
+			errs.Queue(prefix, err)
+		}
+		args = append(args, "--output", format)
+	}
+	if errs.NotEmpty() {
+		ezlog.Err().L().M(errs.Errs()).Out()
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if format == lib.OutputM3u || format == lib.OutputM3u8 {
+		ezlog.Log().M("#EXTM3U").Out()
+	}
+	for _, name := range names {
+		var out bytes.Buffer
+		if err := runSelf(ctx, name, append(slices.Clone(args), "--profile", name), &out); err != nil {
+			errs.Queue(prefix, errors.New(name+": "+err.Error()))
+		}
+		profileOutput(name, format, out.Bytes(), &items)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if format == lib.OutputJson {
+		b, err := json.MarshalIndent(items, "", "  ")
+		if err == nil {
+			ezlog.Log().M(string(b)).Out()
+		} else {
+			errs.Queue(prefix, err)
+		}
+	}
+
+	if errs.NotEmpty() {
+		ezlog.Err().L().M(errs.Errs()).Out()
+	}
+	if ctx.Err() != nil {
+		os.Exit(ExitInterrupted)
+	}
+	if errs.NotEmpty() {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// [args] without --all-profiles
+func profileArgs(args []string) (out []string) {
+	for _, arg := range args {
+		if arg != "--all-profiles" && !strings.HasPrefix(arg, "--all-profiles=") {
+			out = append(out, arg)
+		}
+	}
+	return out
+}
+
+// Print child output [out] of profile [name] in [format] with label. Json items are added to [items].
+//
+// Child errors and diagnostics are on stderr, not in [out]. Json output that cannot be parsed is an error.
+func profileOutput(name, format string, out []byte, items *[]map[string]any) {
+	prefix := "profileOutput"
+	text := strings.TrimRight(string(out), "\n")
+	switch format {
+	case lib.OutputJson:
+		if len(strings.TrimSpace(text)) == 0 {
+			return
+		}
+		var list []map[string]any
+		if err := json.Unmarshal(out, &list); err != nil {
+			errs.Queue(prefix, errors.New(name+": invalid json output: "+err.Error()))
+			return
+		}
+		for _, item := range list {
+			item["Profile"] = name
+		}
+		*items = append(*items, list...)
+		return
+	case lib.OutputNdjson:
+		var lines []string
+		for _, line := range strings.Split(text, "\n") {
+			var item map[string]any
+			if len(line) == 0 {
+				continue
+			}
+			if err := json.Unmarshal([]byte(line), &item); err != nil {
+				errs.Queue(prefix, errors.New(name+": invalid ndjson line: "+err.Error()))
+				continue
+			}
+			item["Profile"] = name
+			if b, err := json.Marshal(item); err == nil {
+				lines = append(lines, string(b))
+			}
+		}
+		text = strings.Join(lines, "\n")
+	case lib.OutputM3u, lib.OutputM3u8, lib.OutputYtdlp:
+		text = "# Profile: " + name + "\n" + strings.TrimPrefix(strings.TrimPrefix(text, "#EXTM3U"), "\n")
+	default:
+		text = "## Profile: " + name + "\n" + text
+	}
+	if len(strings.TrimSpace(text)) > 0 {
+		ezlog.Log().M(text).Out()
+	}
+}
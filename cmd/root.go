@@ -23,13 +23,31 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
 
 	"github.com/J-Siu/go-helper/v2/errs"
 	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/yt-toolbox/v2/conf"
 	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Exit codes, 1 for other errors
+const (
+	ExitSignedOut   = 3   // browser is not signed in
+	ExitConsent     = 4   // consent page
+	ExitCaptcha     = 5   // captcha or unusual traffic page
+	ExitInterrupted = 130 // interrupted by SIGINT/SIGTERM, output is partial
 )
 
 var rootCmd = &cobra.Command{
@@ -37,35 +55,213 @@ var rootCmd = &cobra.Command{
 	Short:   "YouTube toolbox",
 	Version: global.Version,
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// -- Precedence: flag > env > config > default
+		// diagnostics to stderr, stdout is for output only
+		lib.LogSetOut(os.Stdout, os.Stderr)
+		if err := flagEnv(cmd); err != nil {
+			ezlog.Err().M(err).Out()
+			os.Exit(1)
+		}
 		if global.Flag.Debug {
 			ezlog.SetLogLevel(ezlog.DEBUG)
 		}
 		if global.Flag.Trace {
 			ezlog.SetLogLevel(ezlog.TRACE)
 		}
-		host, _ := cmd.Flags().GetString("host")
-		port, _ := cmd.Flags().GetUint("port")
 		ezlog.Debug().
 			N("Version").M(global.Version).
 			Ln("Flag").Lm(&global.Flag).
 			Out()
 		// -- Flags override default and config
 		global.Conf.New()
+		if global.Conf.Err != nil {
+			ezlog.Err().M(global.Conf.Err).Out()
+			// config commands can fix config file
+			if !isConfigCmd(cmd) {
+				os.Exit(1)
+			}
+		}
+		if err := flagConfig(cmd); err != nil {
+			ezlog.Err().M(err).Out()
+			// config commands can fix config file
+			if !isConfigCmd(cmd) {
+				os.Exit(1)
+			}
+		}
+		if global.Flag.AllProfiles {
+			runAllProfiles(cmd)
+		}
+		if err := global.Conf.UseProfile(global.Flag.Profile); err != nil {
+			ezlog.Err().M(err).Out()
+			os.Exit(1)
+		}
+		if len(global.Flag.Profile) > 0 {
+			global.Conf.SetSource("Profile", flagSource("profile"))
+		}
+		host, _ := cmd.Flags().GetString("host")
+		port, _ := cmd.Flags().GetUint("port")
+		if cmd.Flags().Changed("config") {
+			global.Conf.SetSource("FileConf", flagSource("config"))
+		}
 		if len(host) > 0 {
 			global.Conf.DevtoolsHost = host
+			global.Conf.SetSource("DevtoolsHost", flagSource("host"))
 		}
 		if port > 0 {
 			global.Conf.DevtoolsPort = int(port)
+			global.Conf.SetSource("DevtoolsPort", flagSource("port"))
+		}
+		chResolver.New(filepath.Join(global.Conf.DirState, lib.FileChCache), global.Flag.Resolve)
+		if overlay.New(nil, &global.Conf.Overlay).Err != nil {
+			ezlog.Err().M(overlay.Err).Out()
+			os.Exit(1)
 		}
+		elementStat.Overlay = &overlay
+		// -- Progress on terminal only, not with debug output
+		if !global.Flag.NoProgress && !global.Flag.Debug && !global.Flag.Trace && lib.IsTerminal(os.Stderr) {
+			elementStat.Progress = new(lib.Progress).New(os.Stderr)
+			lib.LogSetOut(elementStat.Progress.Writer(os.Stdout), elementStat.Progress.Writer(os.Stderr))
+		}
+		// -- First SIGINT/SIGTERM stops processors at next safe point, second one quits
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		elementStat.Ctx = ctx
+		go func() {
+			<-ctx.Done()
+			stop()
+			ezlog.Err().M("interrupted, stopping at next element. Interrupt again to quit").Out()
+		}()
 	},
 	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		elementStat.Progress.Close()
+		if chResolver.Save().Err != nil {
+			errs.Queue("", chResolver.Err)
+		}
+		if err := elementReport(&elementStat); err != nil {
+			errs.Queue("", err)
+		}
 		if errs.NotEmpty() {
 			ezlog.Err().L().M(errs.Errs()).Out()
+		}
+		if elementStat.Interrupted {
+			ezlog.Err().M("interrupted, output is partial").Out()
+			os.Exit(ExitInterrupted)
+		}
+		if errs.NotEmpty() {
 			os.Exit(1)
 		}
 	},
 }
 
+// Env name by flag name, of persistent flags set from env
+var flagEnvs = make(map[string]string)
+
+// Set persistent flags not on command line from env, eg. YTTB_SCROLL_MAX for --scroll-max
+func flagEnv(cmd *cobra.Command) (err error) {
+	cmd.Root().PersistentFlags().VisitAll(func(f *pflag.Flag) {
+		env := conf.EnvName(f.Name)
+		val := os.Getenv(env)
+		// --all-profiles children inherit env
+		if err != nil || f.Changed || len(val) == 0 || f.Name == "all-profiles" {
+			return
+		}
+		if err = cmd.Flags().Set(f.Name, val); err != nil {
+			err = errors.New(env + ": " + err.Error())
+			return
+		}
+		flagEnvs[f.Name] = env
+	})
+	return err
+}
+
+// Flags not allowed in config Commands
+var flagConfigDeny = []string{"all-profiles", "config", "debug", "help", "trace", "version"}
+
+// Set flags of [cmd] not set by command line or env from config Commands.
+// Sections of parent commands are applied first, eg. "subscription" then "subscription video".
+//
+// Unknown command or flag in any section is an error.
+func flagConfig(cmd *cobra.Command) error {
+	prefix := "Commands"
+	for name, section := range global.Conf.Commands {
+		if _, err := configCmdFind(cmd.Root(), name, section); err != nil {
+			return errors.New(prefix + ": " + err.Error())
+		}
+	}
+	var chain []*cobra.Command
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		chain = append([]*cobra.Command{c}, chain...)
+	}
+	set := make(map[string]bool)
+	for _, c := range chain {
+		name := cmdName(c)
+		for key, val := range global.Conf.Commands[name] {
+			f := cmd.Flags().Lookup(key)
+			// local flag of parent command is not inherited
+			if f == nil {
+				continue
+			}
+			// command line and env take precedence
+			if f.Changed && !set[key] {
+				continue
+			}
+			var err error
+			if list, ok := f.Value.(pflag.SliceValue); ok {
+				err = list.Replace(configStrings(val))
+			} else {
+				err = f.Value.Set(fmt.Sprint(val))
+			}
+			if err != nil {
+				return errors.New(prefix + ": " + name + ": " + key + ": " + err.Error())
+			}
+			f.Changed = true
+			set[key] = true
+			ezlog.Debug().N(prefix).N(name).N(key).M(val).Out()
+		}
+	}
+	return nil
+}
+
+// Return command [name] under [root], eg. "subscription video", if all keys of [flags] are its flags
+func configCmdFind(root *cobra.Command, name string, flags map[string]any) (*cobra.Command, error) {
+	c, _, err := root.Find(strings.Fields(name))
+	if err != nil || cmdName(c) != name {
+		return nil, errors.New("unknown command: " + name)
+	}
+	for key := range flags {
+		if slices.Contains(flagConfigDeny, key) {
+			return nil, errors.New(name + ": flag not allowed: " + key)
+		}
+		if c.Flags().Lookup(key) == nil && c.InheritedFlags().Lookup(key) == nil {
+			return nil, errors.New(name + ": unknown flag: " + key)
+		}
+	}
+	return c, nil
+}
+
+// Return [val] of config as string list
+func configStrings(val any) (list []string) {
+	if items, ok := val.([]any); ok {
+		for _, item := range items {
+			list = append(list, fmt.Sprint(item))
+		}
+		return list
+	}
+	return []string{fmt.Sprint(val)}
+}
+
+// Return [cmd] path without root, eg. "subscription video"
+func cmdName(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
+
+// Return source of flag [name] for config explain
+func flagSource(name string) string {
+	if env, ok := flagEnvs[name]; ok {
+		return conf.SourceEnv + " " + env
+	}
+	return conf.SourceFlag + " --" + name
+}
+
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
@@ -80,7 +276,13 @@ func init() {
 	cmd.PersistentFlags().BoolVarP(&global.Flag.Verbose, "verbose", "v", false, "Verbose")
 	cmd.PersistentFlags().StringVarP(&global.Conf.FileConf, "config", "c", conf.Default.FileConf, "Config file")
 
+	cmd.PersistentFlags().BoolVarP(&global.Flag.AllProfiles, "all-profiles", "", false, "Run command for each profile in config, output is labeled with profile")
+	cmd.PersistentFlags().StringVarP(&global.Flag.As, "as", "", "", "Switch to channel (title, @handle or channel id) before command, abort if switch failed")
+	cmd.PersistentFlags().StringVarP(&global.Flag.Profile, "profile", "", "", "Profile in config")
 	cmd.PersistentFlags().BoolVarP(&global.Flag.Desc, "desc", "", false, "Show description")
+	cmd.PersistentFlags().IntVarP(&global.Flag.MaxErrors, "max-errors", "", 0, "Exit 1 if flagged and skipped elements are more than this")
+	cmd.PersistentFlags().BoolVarP(&global.Flag.NoProgress, "no-progress", "", false, "No progress on terminal")
+	cmd.PersistentFlags().BoolVarP(&global.Flag.Resolve, "resolve", "", false, "Look up channel not in cache from YouTube (default: cache only)")
 	cmd.PersistentFlags().IntVarP(&global.Flag.ScrollMax, "scroll-max", "s", 0, "Unlimited -1 (default: 0)")
 
 	cmd.PersistentFlags().Uint("port", 0, "Devtools Port")
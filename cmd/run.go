@@ -0,0 +1,174 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-helper/v2/file"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/spf13/cobra"
+)
+
+// Commands not allowed in jobs
+var jobCmdDeny = []string{"daemon", "run", "serve"}
+
+// runCmd represents the run command
+var runCmd = &cobra.Command{
+	Use:   "run <job>",
+	Short: "Run named jobs from config",
+	Long: `Run named job in config "Jobs" in a child process, output is written to its sink.
+With --all, all jobs are run one after another by name.
+
+A job has "command", eg. "subscription video", optional "args" and "sink", other keys are flags of the command.
+Sink is "file:<path>" with placeholders {date}, {time}, {job}, file is appended. Stdout if empty.
+Only job output is written to sink, job errors and diagnostics are written to stderr.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "run"
+		names := args
+		if global.FlagRun.All {
+			names = global.Conf.JobNames()
+		}
+		if len(names) == 0 || (global.FlagRun.All && len(args) > 0) {
+			errs.Queue(prefix, errors.New("job name or --all required, jobs: "+strings.Join(global.Conf.JobNames(), ", ")))
+			return
+		}
+		for _, name := range names {
+			if elementStat.Stopped() {
+				break
+			}
+			if err := runJob(elementStat.Ctx, name); err != nil {
+				errs.Queue(prefix, errors.New(name+": "+err.Error()))
+			}
+		}
+		elementStat.Interrupted = elementStat.Stopped()
+	},
+}
+
+func init() {
+	cmd := runCmd
+	rootCmd.AddCommand(cmd)
+
+	cmd.Flags().BoolVarP(&global.FlagRun.All, "all", "a", false, "Run all jobs")
+}
+
+// Run job [name] in config Jobs as a child process
+func runJob(ctx context.Context, name string) (err error) {
+	var (
+		args      []string
+		closeSink func() error
+		sink      string
+		w         io.Writer
+	)
+	if args, sink, err = jobArgs(name); err == nil {
+		w, closeSink, err = jobSink(sink, name, time.Now())
+	}
+	if err == nil {
+		ezlog.Debug().N("run").N(name).M(args).Out()
+		err = runSelf(ctx, name, args, w)
+		err = errors.Join(err, closeSink())
+	}
+	return err
+}
+
+// Return command line args and sink of job [name] in config Jobs
+func jobArgs(name string) (args []string, sink string, err error) {
+	job, ok := global.Conf.Jobs[strings.ToLower(name)]
+	if !ok {
+		return nil, "", errors.New("job not found in config Jobs: " + name)
+	}
+	command, _ := job[conf.JobCommand].(string)
+	sink, _ = job[conf.JobSink].(string)
+	flags := make(map[string]any)
+	for key, val := range job {
+		if key != conf.JobArgs && key != conf.JobCommand && key != conf.JobSink {
+			flags[key] = val
+		}
+	}
+	if fields := strings.Fields(command); len(fields) == 0 || slices.Contains(jobCmdDeny, fields[0]) {
+		return nil, "", errors.New("invalid command: \"" + command + "\"")
+	}
+	if _, err = configCmdFind(rootCmd, command, flags); err != nil {
+		return nil, "", err
+	}
+	args = strings.Fields(command)
+	if val, ok := job[conf.JobArgs]; ok {
+		args = append(args, configStrings(val)...)
+	}
+	keys := make([]string, 0, len(flags))
+	for key := range flags {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		switch val := flags[key].(type) {
+		case map[string]any:
+			return nil, "", errors.New("invalid value of flag: " + key)
+		case []any:
+			for _, item := range val {
+				args = append(args, "--"+key+"="+fmt.Sprint(item))
+			}
+		default:
+			args = append(args, "--"+key+"="+fmt.Sprint(val))
+		}
+	}
+	return args, sink, nil
+}
+
+// Return writer of [sink] for job [name] started at [now], and its close function.
+// Stdout if [sink] is empty.
+func jobSink(sink, name string, now time.Time) (w io.Writer, closeSink func() error, err error) {
+	closeSink = func() error { return nil }
+	if len(sink) == 0 {
+		return os.Stdout, closeSink, nil
+	}
+	path, ok := strings.CutPrefix(sink, "file:")
+	if !ok {
+		return nil, closeSink, errors.New("unsupported sink: " + sink + ", use file:<path>")
+	}
+	path = file.TildeEnvExpand(strings.NewReplacer(
+		"{date}", now.Format(time.DateOnly),
+		"{time}", now.Format("150405"),
+		"{job}", name,
+	).Replace(path))
+	var f *os.File
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err == nil {
+		f, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	}
+	if err != nil {
+		return nil, closeSink, err
+	}
+	return f, f.Close, nil
+}
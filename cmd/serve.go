@@ -0,0 +1,320 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/runZeroInc/go-rod"
+	"github.com/spf13/cobra"
+)
+
+// Playlist id in path, also WL and LL
+var playlistId = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve toolbox operations as HTTP/JSON API",
+	Long: `Serve toolbox operations as HTTP/JSON API. Requests share one browser tab and are run one at a time.
+
+  GET  /subscriptions/channels
+  GET  /subscriptions/videos?day=2
+  GET  /playlists?include=<str>&exclude=<str>
+  GET  /playlists/{id}/videos
+  POST /history/cleanup?filter=<str>&del=true  (dry run without del=true)
+  GET  /subscriptions/videos.atom  (with --atom-interval)
+
+All GET accept "scroll-max" to override --scroll-max.
+
+With --atom-interval, subscription videos are added to a rolling Atom feed file at interval.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "serve"
+		var srv server
+		srv.New(getTab())
+		if global.FlagServe.AtomInterval > 0 {
+			srv.atomFile = global.FlagServe.AtomFile
+			if len(srv.atomFile) == 0 {
+				srv.atomFile = filepath.Join(global.Conf.DirState, lib.FileAtom)
+			}
+		}
+		httpSrv := &http.Server{
+			Addr:    global.FlagServe.Listen,
+			Handler: srv.mux,
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		atomDone := make(chan struct{})
+		go func() {
+			if len(srv.atomFile) > 0 {
+				srv.atomLoop(ctx)
+			}
+			close(atomDone)
+		}()
+		shutdownDone := make(chan struct{})
+		go func() {
+			defer close(shutdownDone)
+			<-ctx.Done()
+			lib.Diag().N(prefix).M("shutdown").Out()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+				ezlog.Err().N(prefix).N("shutdown").M(err).Out()
+			}
+		}()
+		lib.Diag().N(prefix).N("listen").M(global.FlagServe.Listen).Out()
+		if err := httpSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errs.Queue(prefix, err)
+		}
+		// ListenAndServe returns when shutdown starts, handlers may still be running
+		stop()
+		<-shutdownDone
+		<-atomDone
+		// wait for queued jobs, eg. history cleanup, to finish
+		srv.queue.Close()
+	},
+}
+
+func init() {
+	cmd := serveCmd
+	rootCmd.AddCommand(cmd)
+
+	cmd.Flags().StringVarP(&global.FlagServe.Listen, "listen", "l", "127.0.0.1:8080", "Listen address")
+	cmd.Flags().StringVarP(&global.FlagServe.AtomFile, "atom-file", "", "", "Atom feed file (default: "+lib.FileAtom+" in state directory)")
+	cmd.Flags().DurationVarP(&global.FlagServe.AtomInterval, "atom-interval", "", 0, "Update Atom feed of subscription videos at interval, eg. 30m")
+	cmd.Flags().IntVarP(&global.FlagServe.AtomMax, "atom-max", "", lib.AtomMaxEntries, "Max entries in Atom feed")
+}
+
+// HTTP handlers, all browser work go through queue
+type server struct {
+	atomFile string // atom feed disabled if empty
+	mux      *http.ServeMux
+	page     *rod.Page
+	queue    lib.Queue
+}
+
+func (t *server) New(page *rod.Page) *server {
+	t.page = page
+	t.queue.New(16)
+	t.mux = http.NewServeMux()
+	t.mux.HandleFunc("GET /subscriptions/channels", t.subChannels)
+	t.mux.HandleFunc("GET /subscriptions/videos", t.subVideos)
+	t.mux.HandleFunc("GET /subscriptions/videos.atom", t.subVideosAtom)
+	t.mux.HandleFunc("GET /playlists", t.playlists)
+	t.mux.HandleFunc("GET /playlists/{id}/videos", t.playlistVideos)
+	t.mux.HandleFunc("POST /history/cleanup", t.historyCleanup)
+	return t
+}
+
+func (t *server) subChannels(w http.ResponseWriter, r *http.Request) {
+	t.do(w, r, func() (*is.IInfoList, error) {
+		isSubCh := new(lib.IsSubChannel).New(t.page, lib.YT_SubChannels, queryInt(r, "scroll-max", global.Flag.ScrollMax))
+		isSubCh.Resolver = &chResolver
+		isSubCh.Run()
+		if isSubCh.Err == nil {
+			sort.Sort(isSubCh.IInfoList)
+		}
+		return isSubCh.IInfoList, isSubCh.Err
+	})
+}
+
+func (t *server) subVideos(w http.ResponseWriter, r *http.Request) {
+	day := queryInt(r, "day", 0)
+	if day < 0 {
+		httpError(w, http.StatusBadRequest, errors.New("day must not be negative"))
+		return
+	}
+	t.do(w, r, func() (*is.IInfoList, error) {
+		isSubVideo := new(lib.IsSubVideo).New(t.page, lib.YT_SubVideos, queryInt(r, "scroll-max", global.Flag.ScrollMax), uint(day))
+		isSubVideo.Resolver = &chResolver
+		isSubVideo.Run()
+		return isSubVideo.IInfoList, isSubVideo.Err
+	})
+}
+
+func (t *server) subVideosAtom(w http.ResponseWriter, r *http.Request) {
+	if len(t.atomFile) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/atom+xml")
+	http.ServeFile(w, r, t.atomFile)
+}
+
+// Update atom feed file with subscription videos at interval, until [ctx] is done
+func (t *server) atomLoop(ctx context.Context) {
+	prefix := "serve.atom"
+	feed := new(lib.AtomFeed).New(subVideoTitle, lib.YT_SubVideos).Read(t.atomFile)
+	for {
+		err := t.run(ctx, func() error {
+			isSubVideo := new(lib.IsSubVideo).New(t.page, lib.YT_SubVideos, global.Flag.ScrollMax, 0)
+			isSubVideo.Resolver = &chResolver
+			isSubVideo.Run()
+			if isSubVideo.Err != nil {
+				return isSubVideo.Err
+			}
+			return feed.Add(isSubVideo.IInfoList, time.Now()).Trim(global.FlagServe.AtomMax).Write(t.atomFile).Err
+		})
+		if err == nil {
+			lib.Diag().N(prefix).N("entries").M(len(feed.Entries)).N("file").M(t.atomFile).Out()
+		} else {
+			ezlog.Err().N(prefix).M(err).Out()
+		}
+		timer := time.NewTimer(global.FlagServe.AtomInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *server) playlists(w http.ResponseWriter, r *http.Request) {
+	t.do(w, r, func() (*is.IInfoList, error) {
+		var (
+			exclude = r.URL.Query()["exclude"]
+			include = r.URL.Query()["include"]
+			list    = new(is.IInfoList)
+		)
+		isPlaylist := new(lib.IsPlaylist).New(t.page, lib.YT_Playlists, queryInt(r, "scroll-max", global.Flag.ScrollMax), &exclude, &include).Run()
+		if isPlaylist.Err == nil {
+			sort.Sort(isPlaylist.IInfoList)
+			for _, info := range *isPlaylist.IInfoList {
+				if info.Matched() {
+					*list = append(*list, info)
+				}
+			}
+		}
+		return list, isPlaylist.Err
+	})
+}
+
+func (t *server) playlistVideos(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !playlistId.MatchString(id) {
+		httpError(w, http.StatusBadRequest, errors.New("invalid playlist id: "+id))
+		return
+	}
+	t.do(w, r, func() (*is.IInfoList, error) {
+		isVideoList := new(lib.IsPlaylistVideo).New(t.page, lib.YT_Playlist+id, queryInt(r, "scroll-max", global.Flag.ScrollMax))
+		isVideoList.Resolver = &chResolver
+		isVideoList.Run()
+		return isVideoList.IInfoList, isVideoList.Err
+	})
+}
+
+// Dry run unless "del=true"
+func (t *server) historyCleanup(w http.ResponseWriter, r *http.Request) {
+	t.do(w, r, func() (*is.IInfoList, error) {
+		var (
+			entries = new(is.IInfoList)
+			list    = new(is.IInfoList)
+		)
+		isHistorySection := new(lib.IsHistorySection).New(t.page, lib.YT_History, true, queryInt(r, "scroll-max", global.Flag.ScrollMax), false)
+		isHistorySection.Del = r.URL.Query().Get("del") == "true"
+		isHistorySection.Filter = append(isHistorySection.Filter, global.Conf.HistoryFilter...)
+		isHistorySection.Filter = append(isHistorySection.Filter, r.URL.Query()["filter"]...)
+		isHistorySection.Entries = entries
+		isHistorySection.NoPrint = true
+		isHistorySection.PrintHeader = false
+		isHistorySection.Resolver = &chResolver
+		isHistorySection.Run()
+		for _, info := range *entries {
+			if info.Matched() {
+				*list = append(*list, info)
+			}
+		}
+		return list, isHistorySection.Err
+	})
+}
+
+// Run [f] through queue and write result as json
+func (t *server) do(w http.ResponseWriter, r *http.Request, f func() (*is.IInfoList, error)) {
+	prefix := "serve." + r.Method + " " + r.URL.Path
+	var list *is.IInfoList
+	start := time.Now()
+	err := t.run(r.Context(), func() (err error) {
+		list, err = f()
+		return err
+	})
+	w.Header().Set("Content-Type", "application/json")
+	if err == nil {
+		if list == nil {
+			list = new(is.IInfoList)
+		}
+		err = json.NewEncoder(w).Encode(list)
+	} else {
+		httpError(w, http.StatusInternalServerError, err)
+	}
+	if err == nil {
+		lib.Diag().N(prefix).N("items").M(len(*list)).N("duration").M(time.Since(start).Round(time.Millisecond).String()).Out()
+	} else {
+		ezlog.Err().N(prefix).M(err).Out()
+	}
+}
+
+// Run [f] through queue. Errors queued in [errs] during [f] are returned.
+func (t *server) run(ctx context.Context, f func() error) error {
+	return t.queue.Do(ctx, func() (err error) {
+		errs.Clear()
+		err = f()
+		if err == nil && errs.NotEmpty() {
+			err = errors.Join(*errs.Errs()...)
+		}
+		errs.Clear()
+		chResolver.Save()
+		return err
+	})
+}
+
+// Write [err] as json with status [code]
+func httpError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"Err": err.Error()})
+}
+
+// Return query [key] as int, or [def] if not set or invalid
+func queryInt(r *http.Request, key string, def int) int {
+	if v, err := strconv.Atoi(r.URL.Query().Get(key)); err == nil {
+		return v
+	}
+	return def
+}
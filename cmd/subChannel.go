@@ -28,6 +28,7 @@ import (
 	"github.com/J-Siu/go-is/v3/is"
 	"github.com/J-Siu/yt-toolbox/v2/global"
 	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/runZeroInc/go-rod"
 	"github.com/spf13/cobra"
 )
 
@@ -37,17 +38,9 @@ var subChannelCmd = &cobra.Command{
 	Aliases: []string{"c", "ch"},
 	Short:   "Get YT Subscription Channels",
 	Run: func(cmd *cobra.Command, args []string) {
-		page := lib.GetTab(global.Conf.DevtoolsHost, global.Conf.DevtoolsPort)
-
-		isSubCh := new(lib.IsSubChannel).
-			New(
-				page,
-				lib.YT_SubChannels,
-				global.Flag.ScrollMax).
-			Run()
+		isSubCh := subChannel(getTab(), outputStream(is.PrintAll))
 		if isSubCh.Err == nil {
-			sort.Sort(isSubCh.IInfoList)
-			isSubCh.IInfoList.Print(is.PrintAll)
+			printList(isSubCh.IInfoList, is.PrintAll, "Subscription Channels", lib.YT_SubChannels)
 		}
 	},
 }
@@ -55,4 +48,23 @@ var subChannelCmd = &cobra.Command{
 func init() {
 	cmd := subChannelCmd
 	subscriptionsCmd.AddCommand(cmd)
+
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson)
+}
+
+// Get sorted subscription channels. Channels are also written to [stream] if not nil, unsorted.
+func subChannel(page *rod.Page, stream *lib.Stream) *lib.IsSubChannel {
+	isSubCh := new(lib.IsSubChannel).
+		New(
+			page,
+			lib.YT_SubChannels,
+			global.Flag.ScrollMax)
+	isSubCh.Resolver = &chResolver
+	isSubCh.Stat = &elementStat
+	isSubCh.Stream = stream
+	isSubCh.Run()
+	if isSubCh.Err == nil {
+		sort.Sort(isSubCh.IInfoList)
+	}
+	return isSubCh
 }
@@ -23,30 +23,54 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/go-is/v3/is"
 	"github.com/J-Siu/yt-toolbox/v2/global"
 	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/runZeroInc/go-rod"
 	"github.com/spf13/cobra"
 )
 
+// Atom feed title
+const subVideoTitle = "YouTube Subscriptions"
+
 // videosCmd represents the videos command
 var subVideoCmd = &cobra.Command{
 	Use:     "video",
 	Aliases: []string{"v", "videos"},
 	Short:   "Get YT Subscription Videos",
+	Long: `Get YT Subscription Videos.
+
+Filter terms of --include and --exclude: keyword in title (case insensitive), "re:<regex>" of title,
+"ch:<@handle|channel id|title>", or "type:<video|short|live|premiere|members>".
+Exclude overrides include. All videos are included if there is no --include.
+
+With --watch, poll at interval and send new matched videos to sinks in config "Notify".
+Seen videos are kept in state directory. If there is no seen video, first poll only marks videos as seen.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		page := lib.GetTab(global.Conf.DevtoolsHost, global.Conf.DevtoolsPort)
-
-		isSubVideo := new(lib.IsSubVideo).
-			New(
-				page,
-				lib.YT_SubVideos,
-				global.Flag.ScrollMax,
-				global.FlagSub.Day,
-			).
-			Run()
+		prefix := "subscription video"
+		if err := errors.Join(lib.FilterCheck(&global.FlagSub.Include), lib.FilterCheck(&global.FlagSub.Exclude), lib.ShortsCheck(global.Flag.Shorts)); err != nil {
+			errs.Queue(prefix, err)
+			return
+		}
+		page := getTab()
+
+		if global.FlagSub.Watch > 0 {
+			subVideoWatch(page)
+			return
+		}
+		isSubVideo := subVideo(page, &elementStat, outputStream(is.PrintMatched))
 		if isSubVideo.Err == nil {
-			isSubVideo.IInfoList.Print(is.PrintAll)
+			printList(isSubVideo.IInfoList, is.PrintMatched, subVideoTitle, lib.YT_SubVideos)
 		}
 	},
 }
@@ -56,4 +80,84 @@ func init() {
 	subscriptionsCmd.AddCommand(cmd)
 
 	cmd.Flags().UintVarP(&global.FlagSub.Day, "day", "", 0, "number of days (override scroll)")
+	cmd.Flags().StringArrayVarP(&global.FlagSub.Exclude, "exclude", "e", []string{}, "Exclude video matching filter term (Override Include)")
+	cmd.Flags().StringArrayVarP(&global.FlagSub.Include, "include", "i", []string{}, "Include video matching filter term")
+	flagShorts(cmd.Flags(), lib.ShortsInclude)
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson, lib.OutputAtom, lib.OutputYtdlp, lib.OutputM3u, lib.OutputM3u8)
+	flagExec(cmd)
+	cmd.Flags().DurationVarP(&global.FlagSub.Watch, "watch", "w", 0, "Poll interval, eg. 15m. Send new videos to Notify sinks")
+}
+
+// Get subscription videos, element errors are counted in [stat]. Videos are also written to [stream] if not nil.
+func subVideo(page *rod.Page, stat *lib.ElementStat, stream *lib.Stream) *lib.IsSubVideo {
+	isSubVideo := new(lib.IsSubVideo).
+		New(
+			page,
+			lib.YT_SubVideos,
+			global.Flag.ScrollMax,
+			global.FlagSub.Day,
+		)
+	isSubVideo.Exclude = &global.FlagSub.Exclude
+	isSubVideo.Include = &global.FlagSub.Include
+	isSubVideo.Resolver = &chResolver
+	isSubVideo.Shorts = global.Flag.Shorts
+	isSubVideo.Stat = stat
+	isSubVideo.Stream = stream
+	isSubVideo.Run()
+	return isSubVideo
+}
+
+// Poll until SIGINT/SIGTERM
+func subVideoWatch(page *rod.Page) {
+	prefix := "subVideoWatch"
+	var (
+		notifier = new(lib.Notifier).New(&global.Conf.Notify)
+		seen     = new(lib.Seen).New(filepath.Join(global.Conf.DirState, lib.FileSeen))
+		initial  = seen.Len() == 0
+	)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	lib.LogTime(true)
+	for {
+		// element errors are counted per poll
+		stat := lib.ElementStat{Ctx: elementStat.Ctx, Progress: elementStat.Progress, Overlay: elementStat.Overlay}
+		isSubVideo := subVideo(page, &stat, nil)
+		elementStat.Interrupted = elementStat.Interrupted || stat.Interrupted
+		if err := elementReport(&stat); err != nil {
+			ezlog.Err().N(prefix).M(err).Out()
+		}
+		if isSubVideo.Err == nil {
+			var newList is.IInfoList
+			for _, iinfo := range *isSubVideo.IInfoList {
+				info := iinfo.(*lib.YT_Info)
+				if len(info.Url) == 0 || !info.Matched() || !seen.Add(info) {
+					continue
+				}
+				newList = append(newList, info)
+				if initial || !notifier.Match(info) {
+					continue
+				}
+				if err := notifier.Send(info); err != nil {
+					ezlog.Err().N(prefix).N(info.Url).M(err).Out()
+				}
+			}
+			lib.Diag().N(prefix).N("new").M(len(newList)).N("initial").M(initial).Out()
+			// no --exec on videos seen before watch
+			if !initial || len(global.Flag.Exec) == 0 {
+				printList(&newList, is.PrintAll, subVideoTitle, lib.YT_SubVideos)
+			}
+			seen.Save()
+			chResolver.Save()
+			initial = false
+		} else {
+			ezlog.Err().N(prefix).M(isSubVideo.Err).Out()
+		}
+		timer := time.NewTimer(global.FlagSub.Watch)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
 }
@@ -0,0 +1,107 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/global"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// Atom feed title
+const watchLaterTitle = "YouTube Watch Later"
+
+// watchLaterPruneCmd represents the watch later prune command
+var watchLaterPruneCmd = &cobra.Command{
+	Use:     "prune",
+	Aliases: []string{"p"},
+	Short:   "Remove watched videos from Watch Later",
+	Run: func(cmd *cobra.Command, args []string) {
+		page := getTab()
+
+		var (
+			mode    = is.PrintMatched
+			watched map[string]bool
+		)
+		if global.Flag.Verbose {
+			mode = is.PrintAll
+		}
+		if global.FlagWatchLater.History {
+			var entries is.IInfoList
+			isHistorySection := new(lib.IsHistorySection).
+				New(
+					page,
+					lib.YT_History,
+					true,
+					global.Flag.ScrollMax,
+					false)
+			isHistorySection.Entries = &entries
+			isHistorySection.NoPrint = true
+			isHistorySection.PrintHeader = false
+			isHistorySection.Stat = &elementStat
+			isHistorySection.Run()
+			if isHistorySection.Err != nil || elementStat.Interrupted {
+				return
+			}
+			watched = make(map[string]bool)
+			for _, info := range entries {
+				if id := lib.YT_VideoId(info.(*lib.YT_Info).Url); len(id) > 0 {
+					watched[id] = true
+				}
+			}
+		}
+
+		isWatchLater := new(lib.IsWatchLater).
+			New(
+				page,
+				lib.YT_WatchLater,
+				global.Flag.ScrollMax,
+				global.FlagWatchLater.Del,
+				global.FlagWatchLater.Percent,
+				watched)
+		isWatchLater.Resolver = &chResolver
+		isWatchLater.Stat = &elementStat
+		if listOutput() {
+			isWatchLater.Stream = outputStream(mode)
+		}
+		isWatchLater.Run()
+		if isWatchLater.Err == nil && listOutput() {
+			printList(isWatchLater.IInfoList, mode, watchLaterTitle, lib.YT_WatchLater)
+		} else if isWatchLater.Err == nil {
+			lib.ListDesc(isWatchLater.IInfoList, global.Flag.Desc)
+			isWatchLater.Print(global.Flag.Verbose)
+		}
+	},
+}
+
+func init() {
+	cmd := watchLaterPruneCmd
+	watchLaterCmd.AddCommand(cmd)
+
+	cmd.Flags().BoolVarP(&global.FlagWatchLater.Del, "del", "", false, "Perform actual deletion. [default: Dry run]")
+	cmd.Flags().BoolVarP(&global.FlagWatchLater.History, "history", "", false, "Also match videos found in history")
+	cmd.Flags().IntVarP(&global.FlagWatchLater.Percent, "percent", "p", 90, "Match videos watched at or above percentage. 0 to disable")
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson, lib.OutputNdjson, lib.OutputYtdlp, lib.OutputM3u, lib.OutputM3u8)
+	flagExec(cmd)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/J-Siu/go-helper/v2/errs"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/spf13/cobra"
+)
+
+// whoamiCmd represents the whoami command
+var whoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Print active channel of logged in session",
+	Long: `Print active channel of logged in session.
+
+Exit code: 3 not signed in, 4 consent page, 5 captcha or unusual traffic page.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := "whoami"
+		account := new(lib.Account).New(getTab())
+		account.Resolver = &chResolver
+		if account.GetActive().Err != nil {
+			errs.Queue(prefix, account.Err)
+			return
+		}
+		printList(&is.IInfoList{account.Active}, is.PrintAll, "Active", "")
+	},
+}
+
+func init() {
+	cmd := whoamiCmd
+	rootCmd.AddCommand(cmd)
+
+	flagOutput(cmd, lib.OutputMd, lib.OutputJson)
+}
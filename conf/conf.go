@@ -23,13 +23,18 @@ THE SOFTWARE.
 package conf
 
 import (
+	"errors"
+	"os"
+	"slices"
+	"strings"
+
 	"github.com/J-Siu/go-helper/v2/basestruct"
 	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/go-helper/v2/file"
-	"github.com/spf13/viper"
 )
 
 var Default = TypeConf{
+	DirState: "$HOME/.local/state/yt-toolbox",
 	FileConf: "$HOME/.config/yt-toolbox.json",
 
 	DevtoolsHost: "localhost",
@@ -39,12 +44,115 @@ var Default = TypeConf{
 type TypeConf struct {
 	basestruct.Base
 
+	DirState string `json:"DirState"` // channel cache and other states
 	FileConf string `json:"FileConf"`
 
 	HistoryFilter []string `json:"HistoryFilter"`
+	Output        string   `json:"Output"` // default --output, if supported by command
 
+	Browser      string `json:"Browser"`    // browser executable to launch, searched if empty
+	DirBrowser   string `json:"DirBrowser"` // browser user data dir, launched with DevtoolsPort if devtools is not reachable
 	DevtoolsHost string `json:"DevtoolsHost"`
 	DevtoolsPort int    `json:"DevtoolsPort"`
+
+	Profile  string                 `json:"Profile"`  // profile used if --profile is not set
+	Profiles map[string]TypeProfile `json:"Profiles"` // name is case insensitive
+
+	// Flag defaults by command, eg. {"playlist": {"get-list": true}, "subscription video": {"day": 1}}.
+	// Parent command section is applied first.
+	Commands map[string]map[string]any `json:"Commands"`
+
+	// Named jobs run by run command and daemon, see [JobCommand], [JobSink].
+	// Other keys are flags, eg. {"morning-feed": {"command": "subscription video", "day": 1, "sink": "file:~/feeds/{date}.jsonl"}}
+	Jobs map[string]map[string]any `json:"Jobs"`
+
+	Daemon  TypeDaemon  `json:"Daemon"`
+	Notify  TypeNotify  `json:"Notify"`
+	Overlay TypeOverlay `json:"Overlay"`
+
+	Source map[string]string `json:"-" mapstructure:"-"` // source of value by path, see [TypeConf.SourceOf]
+}
+
+// Named profile for an account. Non-empty fields override top level config.
+type TypeProfile struct {
+	DevtoolsHost  string   `json:"DevtoolsHost,omitempty"`
+	DevtoolsPort  int      `json:"DevtoolsPort,omitempty"`
+	DirBrowser    string   `json:"DirBrowser,omitempty"`
+	DirState      string   `json:"DirState,omitempty"`
+	HistoryFilter []string `json:"HistoryFilter,omitempty"`
+	Output        string   `json:"Output,omitempty"`
+}
+
+type TypeDaemon struct {
+	Jobs []TypeDaemonJob `json:"Jobs"`
+}
+
+// Keys of job in [TypeConf.Jobs] which are not flags
+const (
+	JobArgs    = "args"    // positional args, eg. playlist url
+	JobCommand = "command" // eg. "subscription video"
+	JobSink    = "sink"    // "file:<path>" with placeholders {date}, {time}, {job}, stdout if empty
+)
+
+// A daemon job runs yt-toolbox with [Args], or job [Job] in config, on [Schedule]
+type TypeDaemonJob struct {
+	Name     string   `json:"Name"`
+	Schedule string   `json:"Schedule"`      // cron "min hour dom month dow", "@daily", "@every 1h"
+	Args     []string `json:"Args"`          // eg. ["subscription", "video", "--day", "1"]
+	Job      string   `json:"Job,omitempty"` // name in Jobs, instead of Args
+
+	Profile      string `json:"Profile,omitempty"`      // profile in config
+	DevtoolsHost string `json:"DevtoolsHost,omitempty"` // override config and profile
+	DevtoolsPort int    `json:"DevtoolsPort,omitempty"` // override config and profile
+}
+
+// Sinks for new subscription videos in watch mode
+//
+// Placeholders: {url}, {title}, {channel}, {channel_id}, {channel_url}, {id}
+type TypeNotify struct {
+	Channels []string            `json:"Channels"` // only notify these channels by handle, id or title. All if empty
+	Commands []TypeNotifyCommand `json:"Commands"`
+	Smtp     []TypeNotifySmtp    `json:"Smtp"`
+	Webhooks []TypeNotifyWebhook `json:"Webhooks"`
+}
+
+// Run command without shell, placeholders in args are replaced
+type TypeNotifyCommand struct {
+	Args []string `json:"Args"`
+}
+
+type TypeNotifySmtp struct {
+	Host     string   `json:"Host"`
+	Port     int      `json:"Port"`
+	User     string   `json:"User"` // no auth if empty
+	Password string   `json:"Password"`
+	From     string   `json:"From"`
+	To       []string `json:"To"`
+	Subject  string   `json:"Subject"` // default "{channel}: {title}"
+	Body     string   `json:"Body"`    // default "{url}"
+}
+
+// POST video info as json body
+type TypeNotifyWebhook struct {
+	Url     string            `json:"Url"`
+	Headers map[string]string `json:"Headers"`
+}
+
+// Overlays dismissed before checking page, scrolling and clicking
+type TypeOverlay struct {
+	Consent string            `json:"Consent"` // cookie consent: reject (default), accept, ignore
+	Disable []string          `json:"Disable"` // names of built-in overlays not dismissed
+	Custom  []TypeOverlayItem `json:"Custom"`  // more overlays
+}
+
+// Overlay is dismissed by clicking [Button] in visible [Selector]
+type TypeOverlayItem struct {
+	Name       string `json:"Name"`
+	Url        string `json:"Url,omitempty"`        // only if page url contains this
+	Selector   string `json:"Selector"`             // overlay element
+	Text       string `json:"Text,omitempty"`       // js regex of overlay text, eg. "/continue watching/i"
+	Button     string `json:"Button"`               // button in overlay
+	ButtonText string `json:"ButtonText,omitempty"` // js regex of button text
 }
 
 func (t *TypeConf) New() *TypeConf {
@@ -58,24 +166,100 @@ func (t *TypeConf) New() *TypeConf {
 	t.readFileConf()
 	ezlog.Debug().N(prefix).N("Raw").Lm(t).Out()
 
+	t.readEnv()
+	ezlog.Debug().N(prefix).N("Env").Lm(t.Source).Out()
+
 	t.expand()
 	ezlog.Debug().N(prefix).N("Expand").Lm(t).Out()
 
 	return t
 }
 
-func (t *TypeConf) readFileConf() *TypeConf {
-	prefix := t.MyType + ".readFileConf"
+// Apply profile [name] over top level config. [Profile] is used if [name] is empty.
+// Values set by env are kept.
+func (t *TypeConf) UseProfile(name string) error {
+	prefix := t.MyType + ".UseProfile"
+	if len(name) == 0 {
+		name = t.Profile
+	}
+	if len(name) == 0 {
+		return nil
+	}
+	profile, ok := t.Profiles[strings.ToLower(name)]
+	if !ok {
+		return errors.New(prefix + ": profile not found: " + name)
+	}
+	t.Profile = strings.ToLower(name)
+	use := func(path string, set bool, f func()) {
+		if set && !strings.HasPrefix(t.SourceOf(path), SourceEnv) {
+			f()
+			t.SetSource(path, SourceProfile+" "+t.Profile)
+		}
+	}
+	use("DevtoolsHost", len(profile.DevtoolsHost) > 0, func() { t.DevtoolsHost = profile.DevtoolsHost })
+	use("DevtoolsPort", profile.DevtoolsPort > 0, func() { t.DevtoolsPort = profile.DevtoolsPort })
+	use("DirBrowser", len(profile.DirBrowser) > 0, func() { t.DirBrowser = profile.DirBrowser })
+	use("DirState", len(profile.DirState) > 0, func() { t.DirState = profile.DirState })
+	use("HistoryFilter", len(profile.HistoryFilter) > 0, func() { t.HistoryFilter = profile.HistoryFilter })
+	use("Output", len(profile.Output) > 0, func() { t.Output = profile.Output })
+	t.expand()
+	ezlog.Debug().N(prefix).N(name).Lm(t).Out()
+	return nil
+}
 
-	viper.SetConfigType("json")
-	viper.SetConfigFile(file.TildeEnvExpand(t.FileConf))
-	viper.AutomaticEnv()
-	t.Err = viper.ReadInConfig()
+// Sorted job names
+func (t *TypeConf) JobNames() (names []string) {
+	for name := range t.Jobs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
 
-	if t.Err == nil {
-		t.Err = viper.Unmarshal(&t)
-	} else {
+// Sorted profile names
+func (t *TypeConf) ProfileNames() (names []string) {
+	for name := range t.Profiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Read config file, format by extension. Unknown keys and values of wrong type are errors.
+// Missing config file is not an error.
+//
+// With default config file, the first existing one of [FileExts] is used.
+func (t *TypeConf) readFileConf() *TypeConf {
+	prefix := t.MyType + ".readFileConf"
+	var (
+		data     []byte
+		fileType string
+	)
+	if t.FileConf == Default.FileConf {
+		t.FileConf = FileFind(file.TildeEnvExpand(t.FileConf))
+	}
+	path := file.TildeEnvExpand(t.FileConf)
+	if fileType, t.Err = FileType(path); t.Err == nil {
+		data, t.Err = os.ReadFile(path)
+	}
+	if errors.Is(t.Err, os.ErrNotExist) {
 		ezlog.Debug().N(prefix).M(t.Err).Out()
+		t.Err = nil
+		return t
+	}
+	if t.Err == nil {
+		t.Err = Decode(data, fileType, t)
+	}
+	if t.Err == nil {
+		m, _ := decodeMap(data, fileType)
+		for _, field := range t.Fields() {
+			if _, ok := Lookup(m, strings.Split(field.Path, ".")); ok {
+				t.SetSource(field.Path, SourceConfig)
+			}
+		}
+	}
+	if t.Err != nil {
+		t.Err = errors.New(prefix + ": " + path + ": " + t.Err.Error())
 	}
 	return t
 }
@@ -85,12 +269,16 @@ func (t *TypeConf) setDefault() *TypeConf {
 	if t.FileConf == "" {
 		t.FileConf = Default.FileConf
 	}
+	t.Source = nil
+	t.DirState = Default.DirState
 	t.DevtoolsHost = Default.DevtoolsHost
 	t.DevtoolsPort = Default.DevtoolsPort
 	return t
 }
 
 func (t *TypeConf) expand() *TypeConf {
+	t.DirBrowser = file.TildeEnvExpand(t.DirBrowser)
+	t.DirState = file.TildeEnvExpand(t.DirState)
 	t.FileConf = file.TildeEnvExpand(t.FileConf)
 	return t
 }
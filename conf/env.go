@@ -0,0 +1,171 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package conf
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Prefix of environment variables, eg. YTTB_DEVTOOLS_PORT
+const EnvPrefix = "YTTB_"
+
+// Source of config value, from lowest precedence: default, config, profile, env, flag
+const (
+	SourceConfig  = "config"
+	SourceDefault = "default"
+	SourceEnv     = "env"
+	SourceFlag    = "flag"
+	SourceProfile = "profile"
+)
+
+// Config value, for env and explain
+type TypeField struct {
+	Env   string        // env name, empty if not set by env
+	Path  string        // dot separated, eg. Overlay.Consent
+	Value reflect.Value // settable
+}
+
+// Return env name of [name] with [EnvPrefix], eg. DevtoolsHost -> YTTB_DEVTOOLS_HOST, scroll-max -> YTTB_SCROLL_MAX
+func EnvName(name string) string {
+	var b strings.Builder
+	b.WriteString(EnvPrefix)
+	prev := rune(0)
+	for _, r := range name {
+		switch {
+		case r == '-' || r == '.':
+			r = '_'
+		case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+		prev = r
+	}
+	return b.String()
+}
+
+// Return config values of [t]. Structs are walked, maps and lists of struct are one value.
+//
+// String, number, bool and string list can be set by env. String list in env is comma separated.
+func (t *TypeConf) Fields() (fields []TypeField) {
+	return appendFields(fields, reflect.ValueOf(t).Elem(), "")
+}
+
+func appendFields(fields []TypeField, v reflect.Value, parent string) []TypeField {
+	typ := v.Type()
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.Anonymous || name == "-" || len(name) == 0 {
+			continue
+		}
+		path := parent + name
+		if f.Type.Kind() == reflect.Struct {
+			fields = appendFields(fields, v.Field(i), path+".")
+			continue
+		}
+		field := TypeField{Path: path, Value: v.Field(i)}
+		switch f.Type.Kind() {
+		case reflect.Bool, reflect.Int, reflect.String:
+			field.Env = EnvName(path)
+		case reflect.Slice:
+			if f.Type.Elem().Kind() == reflect.String {
+				field.Env = EnvName(path)
+			}
+		}
+		// file is set by env of --config
+		if path == "FileConf" {
+			field.Env = ""
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// Return source of config value [path], [SourceDefault] if not set
+func (t *TypeConf) SourceOf(path string) string {
+	if source, ok := t.Source[path]; ok {
+		return source
+	}
+	return SourceDefault
+}
+
+// Set source of config value [path], eg. "flag --host", "env YTTB_HOST"
+func (t *TypeConf) SetSource(path, source string) {
+	if t.Source == nil {
+		t.Source = make(map[string]string)
+	}
+	t.Source[path] = source
+}
+
+// Set config values from env. Empty env is ignored.
+func (t *TypeConf) readEnv() *TypeConf {
+	prefix := t.MyType + ".readEnv"
+	for _, field := range t.Fields() {
+		if len(field.Env) == 0 {
+			continue
+		}
+		val := os.Getenv(field.Env)
+		if len(val) == 0 {
+			continue
+		}
+		if err := fieldSet(field.Value, val); err != nil {
+			t.Err = errors.Join(t.Err, errors.New(prefix+": "+field.Env+": "+err.Error()))
+			continue
+		}
+		t.SetSource(field.Path, SourceEnv+" "+field.Env)
+	}
+	return t
+}
+
+func fieldSet(v reflect.Value, val string) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int:
+		i, err := strconv.Atoi(val)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(i))
+	case reflect.String:
+		v.SetString(val)
+	case reflect.Slice:
+		var list []string
+		for item := range strings.SplitSeq(val, ",") {
+			if item = strings.TrimSpace(item); len(item) > 0 {
+				list = append(list, item)
+			}
+		}
+		v.Set(reflect.ValueOf(list))
+	}
+	return nil
+}
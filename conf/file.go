@@ -0,0 +1,300 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package conf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+// Config file formats by extension
+const (
+	FileTypeJson = "json"
+	FileTypeToml = "toml"
+	FileTypeYaml = "yaml"
+)
+
+// Extensions tried, in order, if default config file does not exist
+var FileExts = []string{".json", ".yaml", ".yml", ".toml"}
+
+// Return config file format of [path] by extension
+func FileType(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FileTypeJson, nil
+	case ".yaml", ".yml":
+		return FileTypeYaml, nil
+	case ".toml":
+		return FileTypeToml, nil
+	}
+	return "", errors.New("unsupported config file extension: " + path + ", use .json, .yaml, .yml or .toml")
+}
+
+// Return first existing file of [path] with extensions in [FileExts], or [path] if none exists
+func FileFind(path string) string {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	for _, ext := range FileExts {
+		if _, err := os.Stat(base + ext); err == nil {
+			return base + ext
+		}
+	}
+	return path
+}
+
+// Decode config [data] of [fileType] into [conf].
+// Unknown keys and values of wrong type are errors.
+func Decode(data []byte, fileType string, conf *TypeConf) error {
+	v := viper.New()
+	v.SetConfigType(fileType)
+	err := v.ReadConfig(bytes.NewReader(data))
+	if err == nil {
+		err = v.UnmarshalExact(conf, func(c *mapstructure.DecoderConfig) {
+			c.WeaklyTypedInput = false
+		})
+	}
+	return err
+}
+
+// Read config file [path] into map, key case is kept
+func FileRead(path string) (m map[string]any, err error) {
+	var (
+		data     []byte
+		fileType string
+	)
+	if fileType, err = FileType(path); err == nil {
+		data, err = os.ReadFile(path)
+	}
+	if err == nil {
+		m, err = decodeMap(data, fileType)
+	}
+	return m, err
+}
+
+func decodeMap(data []byte, fileType string) (m map[string]any, err error) {
+	m = make(map[string]any)
+	switch fileType {
+	case FileTypeJson:
+		err = json.Unmarshal(data, &m)
+	case FileTypeToml:
+		err = toml.Unmarshal(data, &m)
+	case FileTypeYaml:
+		err = yaml.Unmarshal(data, &m)
+	}
+	return m, err
+}
+
+// Validate [m] against [TypeConf], then write it to config file [path]
+func FileWrite(path string, m map[string]any) (err error) {
+	var (
+		data     []byte
+		fileType string
+	)
+	if fileType, err = FileType(path); err == nil {
+		switch fileType {
+		case FileTypeJson:
+			data, err = json.MarshalIndent(m, "", "  ")
+			data = append(data, '\n')
+		case FileTypeToml:
+			data, err = toml.Marshal(m)
+		case FileTypeYaml:
+			var buf bytes.Buffer
+			enc := yaml.NewEncoder(&buf)
+			enc.SetIndent(2)
+			err = enc.Encode(m)
+			data = buf.Bytes()
+		}
+	}
+	if err == nil {
+		err = Decode(data, fileType, new(TypeConf))
+	}
+	if err == nil {
+		err = fileWrite(path, data)
+	}
+	return err
+}
+
+// Write [data] to [path] through a temp file. Mode of existing file is kept, new file is 0600, it may hold credentials.
+func fileWrite(path string, data []byte) (err error) {
+	mode := os.FileMode(0600)
+	if realPath, err := filepath.EvalSymlinks(path); err == nil {
+		path = realPath
+	}
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, mode); err == nil {
+		// mode of new file is masked by umask, temp file may exist
+		err = os.Chmod(tmp, mode)
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+// Set [key] to [value] in config file [path]. Comments in file are not kept.
+//
+// [key] is dot separated, case insensitive, eg. "Overlay.Consent", "Daemon.Jobs.0.Schedule".
+// [value] is parsed as yaml, eg. "9222", "true", "[a, b]", "{Name: x}".
+func FileSet(path, key, value string) (err error) {
+	var (
+		m   map[string]any
+		val any
+	)
+	if m, err = FileRead(path); errors.Is(err, os.ErrNotExist) {
+		m, err = make(map[string]any), nil
+	}
+	if err == nil {
+		err = yaml.Unmarshal([]byte(value), &val)
+	}
+	if err == nil {
+		err = mapSet(m, KeyPath(key), val)
+	}
+	if err == nil {
+		err = FileWrite(path, m)
+	}
+	return err
+}
+
+// Split dot separated [key] and replace each part with its field name in [TypeConf], if found.
+// Map keys, eg. profile names, and list indexes are kept.
+func KeyPath(key string) (path []string) {
+	typ := reflect.TypeFor[TypeConf]()
+	for part := range strings.SplitSeq(key, ".") {
+		if typ != nil && typ.Kind() == reflect.Slice {
+			typ = typ.Elem()
+			if _, err := strconv.Atoi(part); err == nil {
+				path = append(path, part)
+				continue
+			}
+		}
+		switch {
+		case typ == nil:
+		case typ.Kind() == reflect.Map:
+			typ = typ.Elem()
+		case typ.Kind() == reflect.Struct:
+			if field, ok := typ.FieldByNameFunc(func(name string) bool { return strings.EqualFold(name, part) }); ok {
+				part = strings.Split(field.Tag.Get("json"), ",")[0]
+				typ = field.Type
+			} else {
+				typ = nil
+			}
+		default:
+			typ = nil
+		}
+		path = append(path, part)
+	}
+	return path
+}
+
+// Return value of [path] in [v], map keys are case insensitive
+func Lookup(v any, path []string) (any, bool) {
+	for _, part := range path {
+		switch node := v.(type) {
+		case map[string]any:
+			k, ok := mapKey(node, part)
+			if !ok {
+				return nil, false
+			}
+			v = node[k]
+		case []any:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			v = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// Return existing key of [m] matching [key] case insensitively, or [key] if not found
+func mapKey(m map[string]any, key string) (string, bool) {
+	if _, ok := m[key]; ok {
+		return key, true
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+	return key, false
+}
+
+// Set [path] in [m] to [val], missing maps are created
+func mapSet(m map[string]any, path []string, val any) error {
+	var node any = m
+	for i, part := range path {
+		last := i == len(path)-1
+		switch n := node.(type) {
+		case map[string]any:
+			k, _ := mapKey(n, part)
+			if last {
+				n[k] = val
+				return nil
+			}
+			if _, ok := n[k].(map[string]any); !ok {
+				if _, ok := n[k].([]any); !ok {
+					n[k] = make(map[string]any)
+				}
+			}
+			node = n[k]
+		case []any:
+			idx, err := strconv.Atoi(part)
+			if err != nil || idx < 0 || idx >= len(n) {
+				return errors.New("invalid list index: " + strings.Join(path[:i+1], "."))
+			}
+			if last {
+				n[idx] = val
+				return nil
+			}
+			node = n[idx]
+		default:
+			return errors.New("not a map or list: " + strings.Join(path[:i], "."))
+		}
+	}
+	return errors.New("empty key")
+}
@@ -22,14 +22,26 @@ THE SOFTWARE.
 
 package conf
 
+import "time"
+
 // Holding all flags from command line
 type TypeFlag struct {
 	Debug   bool // Enable debug output
 	Trace   bool // Enable trace output
 	Verbose bool
 
-	Desc      bool
-	ScrollMax int
+	AllProfiles bool   // run command for each profile
+	As          string // channel to switch to before command
+	Desc        bool
+	Exec        string // command template run for each item instead of output
+	ExecJobs    int    // number of exec run in parallel
+	MaxErrors   int    // exit 1 if flagged and skipped elements are more than this
+	NoProgress  bool   // no progress on terminal
+	Output      string // output format: md, json, ndjson, atom, ytdlp, m3u, m3u8
+	Profile     string // profile in config
+	Resolve     bool   // look up channel not in cache remotely
+	ScrollMax   int
+	Shorts      string // shorts policy: include, exclude, only
 }
 
 type TypeFlagPlaylist struct {
@@ -38,6 +50,20 @@ type TypeFlagPlaylist struct {
 	Include []string
 }
 
+type TypeFlagChannel struct {
+	Day uint
+}
+
+type TypeFlagConfig struct {
+	Explain bool   // show source of each value
+	Force   bool   // overwrite existing config file
+	Format  string // config file format, replace extension of config file
+}
+
+type TypeFlagExport struct {
+	Playlists bool
+}
+
 type TypeFlagHistory struct {
 	ClickSleep float32
 	Del        bool
@@ -45,6 +71,30 @@ type TypeFlagHistory struct {
 	NoRemove   bool
 }
 
+type TypeFlagImport struct {
+	Diff bool
+}
+
+type TypeFlagRun struct {
+	All bool // run all jobs
+}
+
+type TypeFlagServe struct {
+	AtomFile     string
+	AtomInterval time.Duration // 0 = no atom feed
+	AtomMax      int
+	Listen       string
+}
+
 type TypeFlagSub struct {
-	Day uint
+	Day     uint
+	Exclude []string      // filter terms of video
+	Include []string      // filter terms of video
+	Watch   time.Duration // poll interval, 0 = no watch
+}
+
+type TypeFlagWatchLater struct {
+	Del     bool
+	History bool
+	Percent int
 }
@@ -0,0 +1,151 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package conf
+
+// Return starter config of [fileType]. Json has no comments.
+func Starter(fileType string) string {
+	switch fileType {
+	case FileTypeToml:
+		return starterToml
+	case FileTypeYaml:
+		return starterYaml
+	}
+	return starterJson
+}
+
+const starterYaml = `# yt-toolbox config
+# Keys are case insensitive. Unknown keys and values of wrong type are errors.
+
+# Channel cache and other states
+DirState: $HOME/.local/state/yt-toolbox
+
+# Devtools of browser with YouTube signed in
+DevtoolsHost: localhost
+DevtoolsPort: 9222
+
+# Browser launched with DirBrowser as user data dir, if devtools is not reachable.
+# Browser is searched if empty.
+# Browser: /usr/bin/chromium
+# DirBrowser: $HOME/.config/yt-toolbox/browser
+
+# Default --output, if supported by command: md, json, ndjson, ytdlp, m3u, atom
+# Output: md
+
+# history --filter
+HistoryFilter: []
+
+# Profile used if --profile is not set
+# Profile: work
+# Profiles:
+#   work:
+#     DevtoolsPort: 9223
+#     DirState: $HOME/.local/state/yt-toolbox-work
+
+# Cookie consent: reject, accept or ignore. Built-in overlays: still-watching, survey, mealbar-promo, popup-promo
+Overlay:
+  Consent: reject
+  Disable: []
+
+# Flag defaults by command, parent command first. Command line and env take precedence.
+# Commands:
+#   playlist:
+#     get-list: true
+#     include: [music]
+#   subscription video:
+#     day: 1
+
+# Jobs run by daemon command
+# Daemon:
+#   Jobs:
+#     - Name: daily
+#       Schedule: "@daily"
+#       Args: [subscription, video, --day, "1"]
+
+# Sinks for new subscription videos in watch mode
+# Notify:
+#   Channels: []
+#   Webhooks:
+#     - Url: https://example.com/hook
+`
+
+const starterToml = `# yt-toolbox config
+# Keys are case insensitive. Unknown keys and values of wrong type are errors.
+
+# Channel cache and other states
+DirState = "$HOME/.local/state/yt-toolbox"
+
+# Devtools of browser with YouTube signed in
+DevtoolsHost = "localhost"
+DevtoolsPort = 9222
+
+# Browser launched with DirBrowser as user data dir, if devtools is not reachable.
+# Browser is searched if empty.
+# Browser = "/usr/bin/chromium"
+# DirBrowser = "$HOME/.config/yt-toolbox/browser"
+
+# Default --output, if supported by command: md, json, ndjson, ytdlp, m3u, atom
+# Output = "md"
+
+# history --filter
+HistoryFilter = []
+
+# Profile used if --profile is not set
+# Profile = "work"
+# [Profiles.work]
+# DevtoolsPort = 9223
+# DirState = "$HOME/.local/state/yt-toolbox-work"
+
+# Cookie consent: reject, accept or ignore. Built-in overlays: still-watching, survey, mealbar-promo, popup-promo
+[Overlay]
+Consent = "reject"
+Disable = []
+
+# Flag defaults by command, parent command first. Command line and env take precedence.
+# [Commands.playlist]
+# get-list = true
+# include = ["music"]
+# [Commands."subscription video"]
+# day = 1
+
+# Jobs run by daemon command
+# [[Daemon.Jobs]]
+# Name = "daily"
+# Schedule = "@daily"
+# Args = ["subscription", "video", "--day", "1"]
+
+# Sinks for new subscription videos in watch mode
+# [[Notify.Webhooks]]
+# Url = "https://example.com/hook"
+`
+
+const starterJson = `{
+  "DirState": "$HOME/.local/state/yt-toolbox",
+  "DevtoolsHost": "localhost",
+  "DevtoolsPort": 9222,
+  "HistoryFilter": [],
+  "Overlay": {
+    "Consent": "reject",
+    "Disable": []
+  }
+}
+`
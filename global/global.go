@@ -25,9 +25,16 @@ package global
 import "github.com/J-Siu/yt-toolbox/v2/conf"
 
 var (
-	Conf         conf.TypeConf
-	Flag         conf.TypeFlag
-	FlagHistory  conf.TypeFlagHistory
-	FlagPlaylist conf.TypeFlagPlaylist
-	FlagSub      conf.TypeFlagSub
+	Conf           conf.TypeConf
+	Flag           conf.TypeFlag
+	FlagChannel    conf.TypeFlagChannel
+	FlagConfig     conf.TypeFlagConfig
+	FlagExport     conf.TypeFlagExport
+	FlagHistory    conf.TypeFlagHistory
+	FlagImport     conf.TypeFlagImport
+	FlagPlaylist   conf.TypeFlagPlaylist
+	FlagRun        conf.TypeFlagRun
+	FlagServe      conf.TypeFlagServe
+	FlagSub        conf.TypeFlagSub
+	FlagWatchLater conf.TypeFlagWatchLater
 )
@@ -24,19 +24,13 @@ package lib
 
 import (
 	"errors"
-	"math/rand/v2"
 	"net/url"
 	"strings"
-	"time"
 
-	"github.com/J-Siu/go-helper/v2/errs"
 	"github.com/J-Siu/go-helper/v2/ezlog"
-	"github.com/J-Siu/go-helper/v2/state"
 	"github.com/J-Siu/go-helper/v2/str"
 	"github.com/J-Siu/go-is/v3/is"
 	"github.com/runZeroInc/go-rod"
-	"github.com/runZeroInc/go-rod/lib/input"
-	"github.com/runZeroInc/go-rod/lib/proto"
 )
 
 // process YT history by entry
@@ -46,13 +40,19 @@ type IsHistoryEntry struct {
 	ClickSleep float64 // in second
 	Del        bool    // delete entry from history
 	Deleted    bool    // In Run(), elements loop, current element is deleted or not
-	Desc       bool    // false;
+	Desc       bool    // include description in Print
+	NoPrint    bool    // false; no printing in Run()
 	Remove     bool    // remove entry from screen
 	Standalone bool    // false;
 	Verbose    bool    // false;
 	Filter     []string
+	Resolver   *ChResolver  // resolve channel if not nil
+	Section    string       // section title
+	Shorts     string       // shorts policy, see [ShortsMatch]. Default: exclude
+	Stat       *ElementStat // element extraction errors
+	Stream     *Stream      // write info as extracted if not nil
 
-	state state.State[V050_StateData]
+	menu Menu3Dot
 }
 
 func (t *IsHistoryEntry) New(property *is.Property, del bool, remove bool, filter *[]string, verbose bool) *IsHistoryEntry {
@@ -63,32 +63,27 @@ func (t *IsHistoryEntry) New(property *is.Property, del bool, remove bool, filte
 	t.Filter = append(t.Filter, *filter...)
 	t.Del = del
 	t.Remove = remove
+	t.Shorts = ShortsExclude
 	t.Verbose = verbose
+	t.Stat = new(ElementStat)
 	t.override()
 
-	t.state = state.State[V050_StateData]{
-		Data: V050_StateData{
-			SleepMax: 300,
-			SleepMin: 100,
-		},
-		OnErr:         t.V051_OnErrFunc,
-		OnErrContinue: true,
-		Pre:           t.V051_FuncPre,
-	}
-	t.state.MyType = t.MyType + ".state"
+	t.menu.New(t.Page, "Remove from watch history")
 
 	return t
 }
 
 func (t *IsHistoryEntry) Run() *IsHistoryEntry {
-	t.Processor.Run()
-	t.Print()
+	ProcessorRun(&t.Processor, t.Stat, t.Stream)
+	if !t.NoPrint {
+		t.Print()
+	}
 	return t
 }
 
 func (t *IsHistoryEntry) override() {
 	t.V020_Elements = t.override_V020_Elements
-	t.V030_ElementInfo = t.override_V030_ElementInfo
+	t.V030_ElementInfo = func() { t.Stat.Guard(&t.Processor, t.override_V030_ElementInfo) }
 	t.V040_ElementMatch = t.override_V040_ElementMatch
 	t.V050_ElementProcessMatched = t.override_V050_ElementProcessMatched
 	t.V060_ElementProcessUnmatch = t.override_V060_ElementProcessUnmatch
@@ -102,8 +97,14 @@ func (t *IsHistoryEntry) override_V020_Elements() {
 	var (
 		tagNames = []string{"ytd-video-renderer", "yt-lockup-view-model"}
 	)
+	// shorts in shelf, all layouts of [shortsLockup]
+	for _, tagName := range strings.Split(shortsLockup, ",") {
+		tagNames = append(tagNames, "ytd-reel-shelf-renderer "+strings.TrimSpace(tagName))
+	}
 	ezlog.Debug().N(prefix).N("Container").M(t.Container).Out()
-	t.V021_ElementsRemoveShorts(t.Container)
+	if t.Shorts == ShortsExclude {
+		t.V021_ElementsRemoveShorts(t.Container)
+	}
 	t.StateCurr.Elements, t.Err = t.Container.Elements(strings.Join(tagNames, ",")) // multiple tag names separate by comma
 	if t.Err != nil {
 		ezlog.Err().N(prefix).M(t.Err).Out()
@@ -123,30 +124,29 @@ func (t *IsHistoryEntry) override_V030_ElementInfo() {
 			elementsText      rod.Elements
 			elementsTextCount int
 		)
+		t.StateCurr.ElementInfo = &info
+		x := Extract{E: t.StateCurr.Element, Info: &info}
 		by = "#video-title"                                // by ID
 		elementMeta, err = t.StateCurr.Element.Element(by) // by id
-		if err == nil {
+		if short, _ := t.StateCurr.Element.Matches(shortsLockup); short {
+			// short has title and link only, channel is resolved below
+			info.Title = x.Text(shortsTitle)
+			info.Url = YT_FullUrl(x.Attr(shortsLink, "href"))
+			info.Type = YT_TypeShort
+		} else if err == nil {
 			// -- trace
 			TraceElement(ezlog.TRACE, prefix, "", elementMeta)
-			info.Title = strings.TrimSpace(elementMeta.MustText())
+			xm := Extract{E: elementMeta, Info: &info}
+			info.Title = xm.Text("")
 			if len(info.Title) != 0 {
-				info.Url = YT_FullUrl(*elementMeta.MustAttribute("href"))
-				info.Text = strings.TrimSpace(t.StateCurr.Element.MustElement("#description-text").MustText()) // by id
-
-				meta := t.StateCurr.Element.MustElement("#metadata") // by id
-				a := meta.MustElement("a")                           // by name
-				info.ChTitle = strings.TrimSpace(a.MustText())
-
-				if a != nil {
-					chUrlP := a.MustAttribute("href")
-					if chUrlP != nil {
-						info.ChUrl = YT_FullUrl(*chUrlP)
-						parsedUrl, err := url.Parse(info.ChUrl)
-						if err == nil {
-							info.ChUrlShort = parsedUrl.Path
-						}
-					} else {
-						ezlog.Debug().N(prefix).M("chUrlP == nil").Out()
+				info.Url = YT_FullUrl(xm.Attr("", "href"))
+				info.Text = x.Text("#description-text") // by id
+				info.ChTitle = x.Text("#metadata a")
+				if chUrl := x.Attr("#metadata a", "href"); len(chUrl) > 0 {
+					info.ChUrl = YT_FullUrl(chUrl)
+					parsedUrl, err := url.Parse(info.ChUrl)
+					if err == nil {
+						info.ChUrlShort = parsedUrl.Path
 					}
 				}
 			}
@@ -160,35 +160,48 @@ func (t *IsHistoryEntry) override_V030_ElementInfo() {
 				// 	traceElement(prefix, by, elementMeta)
 				// }
 				// -- title
-				info.Title = elementMeta.MustElement("a").MustText()
-				info.Url = YT_FullUrl(*elementMeta.MustElement("a").MustAttribute("href"))
+				xm := Extract{E: elementMeta, Info: &info}
+				info.Title = xm.Text("a")
+				info.Url = YT_FullUrl(xm.Attr("a", "href"))
 				by = "[role='text']"
-				elementsText = elementMeta.MustElements(by)
+				elementsText, _ = elementMeta.Elements(by)
 				elementsTextCount = len(elementsText)
 				ezlog.Info().N(prefix).N(by).N("elementsText len").M(elementsTextCount).Out()
+				texts := make([]string, elementsTextCount)
+				for i, e := range elementsText {
+					texts[i] = (&Extract{E: e, Info: &info}).Text("")
+					ezlog.Info().N(prefix).N(by).N(i).M(texts[i]).Out()
+				}
 				if elementsTextCount < 2 {
 					ezlog.Warning().N(prefix).N(by).M("YT changed format").Out()
+					info.AddErr(by, errors.New("channel not found"))
+				} else {
+					// texts[0] is title
+					info.ChTitle = texts[1]
 				}
-				for i, e := range elementsText {
-					ezlog.Info().N(prefix).N(by).N(i).M(e.MustText()).Out()
+				if a, e := elementMeta.Element("a[href^='/@'],a[href^='/channel/']"); e == nil {
+					info.ChUrlShort = UrlDecode((&Extract{E: a, Info: &info}).Attr("", "href"))
+					info.ChUrl = YT_FullUrl(info.ChUrlShort)
 				}
-				// info.Title = elementsText[0].MustText()
-				info.ChTitle = elementsText[1].MustText()
 				switch elementsTextCount {
-				case 2:
+				case 0, 1, 2:
 					// member video don't have views
 				case 3:
-					info.Text = elementsText[2].MustText()
+					info.Text = texts[2]
 				case 4:
-					info.Text = elementsText[3].MustText()
+					info.Text = texts[3]
 				default:
 					TraceElement(ezlog.ERR, prefix, by, t.StateCurr.Element)
 				}
 			} else {
 				TraceElement(ezlog.ERR, prefix, "YT format not recognize", t.StateCurr.Element)
+				info.AddErr(by, err)
 			}
 		}
-		t.StateCurr.ElementInfo = &info
+		if t.Resolver != nil && len(info.Title) != 0 {
+			t.Resolver.ResolveVideo(t.Stat.Context(), &info)
+		}
+		info.Section = t.Section
 		ezlog.Debug().N(prefix).N("info").M(info.String()).Out()
 	}
 }
@@ -204,6 +217,7 @@ func (t *IsHistoryEntry) override_V040_ElementMatch() {
 	info := t.StateCurr.ElementInfo.(*YT_Info)
 	chkStr := info.Title + " " + info.Text + " " + info.ChTitle + " " + info.ChUrlShort
 	matched, matchedStr = str.ContainsAnySubStrings(chkStr, &t.Filter, false)
+	matched = matched && ShortsMatch(info, t.Shorts)
 	t.StateCurr.ElementInfo.SetMatched(matched)
 	t.StateCurr.ElementInfo.SetMatchedStr(matchedStr)
 }
@@ -211,9 +225,7 @@ func (t *IsHistoryEntry) override_V040_ElementMatch() {
 func (t *IsHistoryEntry) override_V050_ElementProcessMatched() {
 	prefix := t.MyType + ".V050_ElementProcessMatched"
 	t.StateCurr.Name = prefix
-	if t.Del && t.StateCurr.Element.MustVisible() {
-		t.state.Run(t.V0511_WaitStable)
-	}
+	t.Deleted = t.menu.Delete(&t.Processor, t.Stat, t.Del)
 }
 
 func (t *IsHistoryEntry) override_V060_ElementProcessUnmatch() {
@@ -224,8 +236,9 @@ func (t *IsHistoryEntry) override_V060_ElementProcessUnmatch() {
 func (t *IsHistoryEntry) override_V080_ElementScrollable() {
 	prefix := t.MyType + ".V080_ElementScrollable"
 	t.StateCurr.Name = prefix
-	info := t.StateCurr.ElementInfo.(*YT_Info)
-	t.StateCurr.ElementScrollable = !t.Deleted && (len(info.Title) == 0 || !t.StateCurr.Element.MustVisible())
+	// skipped element has no info
+	info, _ := t.StateCurr.ElementInfo.(*YT_Info)
+	t.StateCurr.ElementScrollable = !t.Deleted && (info == nil || len(info.Title) == 0 || !Visible(t.StateCurr.Element))
 }
 
 func (t *IsHistoryEntry) override_V100_ScrollLoopEnd() {
@@ -238,8 +251,8 @@ func (t *IsHistoryEntry) override_V100_ScrollLoopEnd() {
 			}
 			t.StateCurr.ElementsCount = 0
 		}
-		es := t.Page.MustElements("ytd-item-section-renderer")
-		if es != nil {
+		es, err := t.Page.Elements("ytd-item-section-renderer")
+		if err == nil && es != nil {
 			t.StateCurr.Element = es.Last()
 			if t.StateCurr.ScrollableElement != nil && t.StateCurr.Element != nil && (t.StateCurr.ScrollableElement.Object.ObjectID != t.StateCurr.Element.Object.ObjectID) {
 				t.StateCurr.Scroll = true
@@ -280,191 +293,6 @@ func (t *IsHistoryEntry) V021_ElementsRemoveShorts(element *rod.Element) *IsHist
 	return t
 }
 
-type V050_StateData struct {
-	Element  *rod.Element
-	SleepMax int64
-	SleepMin int64
-}
-
-// sleep between min and max ms before each state
-func (t *IsHistoryEntry) V051_FuncPre() *state.State[V050_StateData] {
-	prefix := t.MyType + ".V051_FuncPre"
-	t.state.Name = prefix
-	var (
-		duration int64
-	)
-	duration = t.state.Data.SleepMin + rand.Int64N(t.state.Data.SleepMax-t.state.Data.SleepMin)
-	ezlog.Info().N(prefix).N("sleep(ms)").M(int64(duration)).Out()
-	time.Sleep(time.Millisecond * time.Duration(duration))
-	return &t.state
-}
-
-func (t *IsHistoryEntry) V051_OnErrFunc() *state.State[V050_StateData] {
-	errs.Queue(t.state.Name, t.state.Err)
-	return &t.state
-}
-
-func (t *IsHistoryEntry) V0511_WaitStable() *state.State[V050_StateData] {
-	prefix := t.MyType + ".V0511"
-	t.state.Name = prefix
-	t.state.Err = t.Page.Keyboard.Press(input.Escape)
-	if t.state.Err == nil {
-		t.Page.Mouse.Scroll(0, 12, 3)
-		// WaitPageStable(prefix, t.Page)
-		t.state.Next = t.V0512_3DotClick
-	} else {
-		t.state.Next = t.V0511_WaitStable
-	}
-	return &t.state
-}
-
-// Click the 3-dot button to open popup menu
-func (t *IsHistoryEntry) V0512_3DotClick() *state.State[V050_StateData] {
-	prefix := t.MyType + ".V0512"
-	t.state.Name = prefix
-	var (
-		tags = []string{
-			"button",
-			".yt-lockup-metadata-view-model__menu-button"}
-	)
-	// select 3-dot
-	for _, tag := range tags {
-		t.state.Data.Element, t.state.Err = t.StateCurr.Element.Element(tag)
-		if t.state.Err == nil {
-			break
-		}
-	}
-	if t.state.Err != nil {
-		t.state.Next = nil
-	}
-	ezlog.Debug().N(prefix).N("t.state.Err").M(t.state.Err).Out()
-	if t.state.Err == nil {
-		// make 3-dot within screen
-		// t.state.Data.Element.MustVisible()
-		// click 3-dot
-		t.state.Err = t.state.Data.Element.Click(proto.InputMouseButtonLeft, 1)
-		ezlog.Debug().N(prefix).M("clicked").Out()
-	}
-	if t.state.Err == nil {
-		t.state.Next = t.V0513_MenuSelect
-	} else {
-		t.state.Next = t.V0511_WaitStable
-	}
-	return &t.state
-}
-
-// Select the popup menu
-func (t *IsHistoryEntry) V0513_MenuSelect() *state.State[V050_StateData] {
-	prefix := t.MyType + ".V0513"
-	t.state.Name = prefix
-	var (
-		elements rod.Elements
-		// tag      = "tp-yt-paper-listbox,tp-yt-iron-dropdown"
-		tag = "#contentWrapper"
-	)
-	// select menu
-	t.state.Data.Element = nil
-	elements, t.state.Err = t.Page.Elements(tag) // tag
-	if t.state.Err == nil {
-		for _, element := range elements {
-			if element.MustVisible() {
-				t.state.Data.Element = element
-				t.state.Next = t.V0514_MenuRead
-				break
-			}
-		}
-		if t.state.Data.Element == nil {
-			t.state.Err = errors.New("cannot select menu")
-		}
-	}
-	if t.state.Err != nil {
-		t.state.Next = t.V0511_WaitStable
-	}
-	return &t.state
-}
-
-// Read menu items
-func (t *IsHistoryEntry) V0514_MenuRead() *state.State[V050_StateData] {
-	prefix := t.MyType + ".V0514"
-	t.state.Name = prefix
-	// TraceElement(prefix, "", t.state.Element)
-	var (
-		matched         bool
-		menuItems       rod.Elements
-		menuItemText    string
-		menuItemTextReq = "Remove from watch history"
-		tag             = "ytd-menu-service-item-renderer,yt-list-item-view-model"
-	)
-	menuItems, t.state.Err = t.state.Data.Element.Elements(tag) // tag
-	if t.state.Err == nil {
-		if len(menuItems) > 0 {
-			for _, item := range menuItems {
-				menuItemText = strings.TrimSpace(item.MustText())
-				ezlog.Trace().N(prefix).N("menuItems").M("'" + menuItemText + "'").Out()
-				if strings.EqualFold(menuItemText, menuItemTextReq) {
-					t.state.Data.Element = item
-					t.state.Next = t.V0515_MenuClick
-					matched = true
-					break
-				}
-			}
-			if !matched {
-				TraceElement(ezlog.TRACE, prefix, "", t.state.Data.Element)
-				t.state.Err = errors.New("unmatch: " + menuItemTextReq)
-			}
-		} else {
-			t.state.Err = errors.New("0 menu item")
-		}
-	}
-	if t.state.Err != nil {
-		t.state.Next = t.V0511_WaitStable
-	}
-	return &t.state
-}
-
-// Click the item, use t.state.Element from V0513
-func (t *IsHistoryEntry) V0515_MenuClick() *state.State[V050_StateData] {
-	prefix := t.MyType + ".V0515"
-	t.state.Name = prefix
-	TraceElement(ezlog.TRACE, prefix, "", t.state.Data.Element)
-	var (
-		x, y  float64
-		box   *proto.DOMRect
-		shape *proto.DOMGetContentQuadsResult
-	)
-	{
-		// -- just click
-		// t.state.Err = t.state.Data.Element.Click(proto.InputMouseButtonLeft, 1)
-	}
-	{
-		// -- random position click
-		shape, t.state.Err = t.state.Data.Element.Shape()
-		if t.state.Err == nil {
-			if shape == nil {
-				t.state.Err = errors.New("nil shape")
-			} else {
-				box = shape.Box()
-				ezlog.Trace().N(prefix).N("box").M(box).Out()
-				if box == nil {
-					t.state.Err = errors.New("nil box")
-				} else {
-					x = box.X + 1 + rand.Float64()*(box.Width-2)
-					y = box.Y + 1 + rand.Float64()*(box.Height-2)
-					t.Page.Mouse.MustMoveTo(x, y).MustClick(proto.InputMouseButtonLeft)
-					t.Deleted = true
-				}
-			}
-		}
-	}
-	if t.state.Err == nil {
-		t.state.Next = nil
-	} else {
-		// TraceElement(prefix, t.state.Err.Error(), t.state.Element)
-		t.state.Next = t.V0511_WaitStable
-	}
-	return &t.state
-}
-
 func (t *IsHistoryEntry) Print() *IsHistoryEntry {
 	var (
 		infoList *is.IInfoList
@@ -473,18 +301,19 @@ func (t *IsHistoryEntry) Print() *IsHistoryEntry {
 
 	if t.Verbose {
 		mode = is.PrintAll
-		infoList = t.IInfoList
 	} else {
 		mode = is.PrintMatched
-		infoList = new(is.IInfoList)
-		for _, info := range *t.IInfoList {
-			if info.Matched() {
-				*infoList = append(*infoList, info)
-			}
+	}
+	// entries excluded by shorts policy are not printed
+	infoList = new(is.IInfoList)
+	for _, info := range *t.IInfoList {
+		if info.Matched() || (t.Verbose && ShortsMatch(info.(*YT_Info), t.Shorts)) {
+			*infoList = append(*infoList, info)
 		}
 	}
 
 	if len(*infoList) > 0 {
+		ListDesc(infoList, t.Desc)
 		ezlog.Log().M("no|match|video|ch|desc").Out()
 		ezlog.Log().M("--|--|--|--|--").Out()
 		infoList.Print(mode)
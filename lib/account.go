@@ -0,0 +1,271 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/runZeroInc/go-rod"
+	"github.com/runZeroInc/go-rod/lib/input"
+	"github.com/runZeroInc/go-rod/lib/proto"
+)
+
+// Account menu
+const (
+	AccountSwitchText = "Switch account" // menu item opening channel list, case insensitive
+	AccountTimeout    = 15 * time.Second // wait for menu, and page reload after switch
+
+	accountAvatar = "button#avatar-btn"
+	accountHeader = "ytd-active-account-header-renderer"
+	accountItem   = "ytd-account-item-renderer"
+	accountLink   = "ytd-compact-link-renderer"
+)
+
+// Channels (brand accounts) of logged in session, read and switched through account menu
+type Account struct {
+	basestruct.Base
+
+	Active   *YT_Info     // active channel, updated by each method
+	List     is.IInfoList // channels in account switcher, active one is matched
+	Page     *rod.Page
+	Resolver *ChResolver // fill channel id if not nil
+
+	elements rod.Elements // elements of [List]
+}
+
+func (t *Account) New(page *rod.Page) *Account {
+	t.Initialized = true
+	t.MyType = "Account"
+	t.Page = page
+	return t
+}
+
+// Read active channel
+func (t *Account) GetActive() *Account {
+	prefix := t.MyType + ".GetActive"
+	page := t.Page.Timeout(AccountTimeout)
+	t.Err = t.openMenu(page)
+	t.closeMenu()
+	if t.Err != nil {
+		t.Err = errors.New(prefix + ": " + t.Err.Error())
+	}
+	return t
+}
+
+// Read channels in account switcher
+func (t *Account) GetList() *Account {
+	prefix := t.MyType + ".GetList"
+	page := t.Page.Timeout(AccountTimeout)
+	t.Err = t.openMenu(page)
+	if t.Err == nil {
+		t.Err = t.openList(page)
+	}
+	t.closeMenu()
+	if t.Err != nil {
+		t.Err = errors.New(prefix + ": " + t.Err.Error())
+	}
+	return t
+}
+
+// Switch to channel [target]: handle, channel id, or title if unique. Active channel is verified after switch.
+func (t *Account) Use(target string) *Account {
+	prefix := t.MyType + ".Use"
+	page := t.Page.Timeout(AccountTimeout)
+	t.Err = t.openMenu(page)
+	if t.Err == nil && AccountMatch(t.Active, target) {
+		ezlog.Debug().N(prefix).N("already active").M(target).Out()
+		t.closeMenu()
+		return t
+	}
+	if t.Err == nil {
+		t.Err = t.openList(page)
+	}
+	var (
+		element  *rod.Element
+		selected *YT_Info
+	)
+	if t.Err == nil {
+		element, selected, t.Err = t.find(target)
+	}
+	if t.Err == nil && selected.Matched() {
+		ezlog.Debug().N(prefix).N("already active").M(target).Out()
+		t.closeMenu()
+		return t
+	}
+	if t.Err == nil {
+		ezlog.Info().N(prefix).M(target).Out()
+		// switch reloads page
+		wait := t.Page.Timeout(AccountTimeout).WaitNavigation(proto.PageLifecycleEventNameLoad)
+		if t.Err = element.Click(proto.InputMouseButtonLeft, 1); t.Err == nil {
+			wait()
+		}
+	}
+	if t.Err == nil {
+		t.Err = t.openMenu(t.Page.Timeout(AccountTimeout))
+		t.closeMenu()
+	}
+	if t.Err == nil && !AccountMatch(t.Active, target) && !accountSame(t.Active, selected) {
+		t.Err = errors.New("switch failed, active channel is " + t.Active.Title + " " + t.Active.ChUrlShort)
+	}
+	if t.Err != nil {
+		t.closeMenu()
+		t.Err = errors.New(prefix + ": " + target + ": " + t.Err.Error())
+	}
+	return t
+}
+
+// Account of [target] in [Account.List]: by handle or channel id, else by title if only one account has that title
+func (t *Account) find(target string) (element *rod.Element, info *YT_Info, err error) {
+	var titled []int
+	for i, iinfo := range t.List {
+		if AccountMatch(iinfo.(*YT_Info), target) {
+			return t.elements[i], iinfo.(*YT_Info), nil
+		}
+		if strings.EqualFold(iinfo.(*YT_Info).Title, strings.TrimSpace(target)) {
+			titled = append(titled, i)
+		}
+	}
+	switch len(titled) {
+	case 0:
+		err = errors.New("channel not found in account switcher: " + target)
+	case 1:
+		element, info = t.elements[titled[0]], t.List[titled[0]].(*YT_Info)
+	default:
+		// titles are not unique, a wrong channel must not be used
+		err = errors.New(strconv.Itoa(len(titled)) + " channels titled " + target + " in account switcher, use @handle or channel id")
+	}
+	return element, info, err
+}
+
+// Return true if channel [info] is [target]: handle with or without "@", or channel id. Case insensitive.
+// Title is not matched, it is not unique.
+func AccountMatch(info *YT_Info, target string) bool {
+	if info == nil {
+		return false
+	}
+	target = strings.TrimSpace(target)
+	handle := strings.TrimPrefix(info.ChUrlShort, "/@")
+	return len(handle) > 0 && strings.EqualFold(handle, strings.TrimPrefix(target, "@")) ||
+		len(info.ChId) > 0 && strings.EqualFold(info.ChId, target)
+}
+
+// Compare by handle if both have one, else by title
+func accountSame(a, b *YT_Info) bool {
+	if len(a.ChUrlShort) > 0 && len(b.ChUrlShort) > 0 {
+		return strings.EqualFold(a.ChUrlShort, b.ChUrlShort)
+	}
+	return a.Title == b.Title
+}
+
+// Open account menu and read active channel
+func (t *Account) openMenu(page *rod.Page) (err error) {
+	var (
+		avatar, header *rod.Element
+		target         *proto.TargetTargetInfo
+	)
+	if target, err = page.Info(); err == nil && !strings.HasPrefix(target.URL, YT_Base) {
+		if err = page.Navigate(YT_Base); err == nil {
+			err = page.WaitLoad()
+		}
+	}
+	if err == nil {
+		avatar, err = page.Element(accountAvatar)
+	}
+	if err == nil {
+		err = avatar.Click(proto.InputMouseButtonLeft, 1)
+	}
+	if err == nil {
+		header, err = page.Element(accountHeader)
+	}
+	if err == nil {
+		t.Active = t.channel(header, "#account-name")
+		t.Active.SetMatched(true)
+		if len(t.Active.Title) == 0 {
+			err = errors.New("active channel not found")
+		}
+	}
+	return err
+}
+
+// Open channel list in opened account menu, and read channels
+func (t *Account) openList(page *rod.Page) (err error) {
+	var link *rod.Element
+	link, err = page.ElementR(accountLink, "/"+AccountSwitchText+"/i")
+	if err == nil {
+		err = link.Click(proto.InputMouseButtonLeft, 1)
+	}
+	if err == nil {
+		_, err = page.Element(accountItem)
+	}
+	if err == nil {
+		t.elements, err = page.Elements(accountItem)
+	}
+	if err == nil {
+		t.List = nil
+		for _, e := range t.elements {
+			info := t.channel(e, "#channel-title")
+			info.Text = accountText(e, "#subscriber-count")
+			info.SetMatched(accountSame(t.Active, info))
+			t.List = append(t.List, info)
+		}
+	}
+	return err
+}
+
+// Channel in [e] with title in [selectorTitle]
+func (t *Account) channel(e *rod.Element, selectorTitle string) *YT_Info {
+	info := new(YT_Info)
+	info.Title = accountText(e, selectorTitle)
+	info.ChTitle = info.Title
+	if handle := accountText(e, "#channel-handle"); strings.HasPrefix(handle, "@") {
+		info.ChUrlShort = "/" + handle
+		info.ChUrl = YT_FullUrl(info.ChUrlShort)
+		info.Url = info.ChUrl
+		if t.Resolver != nil {
+			t.Resolver.Resolve(info)
+		}
+	}
+	return info
+}
+
+func (t *Account) closeMenu() {
+	if err := t.Page.Keyboard.Press(input.Escape); err != nil {
+		ezlog.Debug().N(t.MyType).M(err).Out()
+	}
+}
+
+// Trimmed text of optional child [selector] of [e], empty if not found
+func accountText(e *rod.Element, selector string) string {
+	if child, err := e.Element(selector); err == nil {
+		if text, err := child.Text(); err == nil {
+			return strings.TrimSpace(text)
+		}
+	}
+	return ""
+}
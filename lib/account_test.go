@@ -0,0 +1,65 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/runZeroInc/go-rod"
+)
+
+func TestAccountFind(t *testing.T) {
+	var account Account
+	for _, ch := range []YT_Info{
+		{Title: "Main", ChUrlShort: "/@main"},
+		{Title: "Brand", ChUrlShort: "/@brand1", ChId: "UC1"},
+		{Title: "Brand", ChUrlShort: "/@brand2"},
+	} {
+		account.List = append(account.List, &ch)
+		account.elements = append(account.elements, new(rod.Element))
+	}
+	tests := []struct {
+		target string
+		want   int // index in list, -1 if error
+	}{
+		{"@main", 0},
+		{"main", 0},
+		{"Main", 0},
+		{"@BRAND2", 2},
+		{"UC1", 1},
+		{"Brand", -1},
+		{"other", -1},
+	}
+	for _, tt := range tests {
+		element, info, err := account.find(tt.target)
+		if tt.want < 0 {
+			if err == nil {
+				t.Errorf("find(%q) = %v, want error", tt.target, info)
+			}
+			continue
+		}
+		if err != nil || element != account.elements[tt.want] || info != account.List[tt.want] {
+			t.Errorf("find(%q) = %v, %v, want %d", tt.target, info, err, tt.want)
+		}
+	}
+}
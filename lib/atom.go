@@ -0,0 +1,188 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-helper/v2/file"
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+// Atom feed file name in state directory
+const FileAtom = "subscriptions.atom"
+
+// Default max entries kept in a rolling feed
+const AtomMaxEntries = 200
+
+// Atom feed of [YT_Info]. Entries are kept newest first, by id.
+type AtomFeed struct {
+	basestruct.Base `xml:"-"`
+
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Id      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    AtomLink    `xml:"link"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+type AtomAuthor struct {
+	Name string `xml:"name"`
+	Uri  string `xml:"uri,omitempty"`
+}
+
+type AtomEntry struct {
+	Id        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Link      AtomLink   `xml:"link"`
+	Author    AtomAuthor `xml:"author"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Summary   string     `xml:"summary,omitempty"`
+}
+
+type AtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+func (t *AtomFeed) New(title, urlStr string) *AtomFeed {
+	t.Initialized = true
+	t.MyType = "AtomFeed"
+	t.Id = urlStr
+	t.Title = title
+	t.Link = AtomLink{Href: urlStr, Rel: "alternate"}
+	return t
+}
+
+// Load entries from [fileAtom] if it exists, for rolling update
+func (t *AtomFeed) Read(fileAtom string) *AtomFeed {
+	prefix := t.MyType + ".Read"
+	if file.IsRegularFile(fileAtom) {
+		var (
+			b    *[]byte
+			feed AtomFeed
+		)
+		b, t.Err = file.ReadByte(fileAtom)
+		if t.Err == nil {
+			t.Err = xml.Unmarshal(*b, &feed)
+		}
+		if t.Err == nil {
+			t.Entries = feed.Entries
+		} else {
+			ezlog.Err().N(prefix).N(fileAtom).M(t.Err).Out()
+		}
+	}
+	return t
+}
+
+// Add [YT_Info] in [list] with url. Existing entries keep their published time.
+// Published time is calculated from relative date in [YT_Info.Text], or [now].
+func (t *AtomFeed) Add(list *is.IInfoList, now time.Time) *AtomFeed {
+	index := make(map[string]int)
+	for i, entry := range t.Entries {
+		index[entry.Id] = i
+	}
+	for _, iinfo := range *list {
+		info, ok := iinfo.(*YT_Info)
+		if !ok || len(info.Url) == 0 {
+			continue
+		}
+		published, ok := YT_RelTime(info.Text, now)
+		if !ok {
+			published = now
+		}
+		entry := AtomEntry{
+			Id:        atomId(info),
+			Title:     info.Title,
+			Link:      AtomLink{Href: info.Url, Rel: "alternate"},
+			Author:    AtomAuthor{Name: info.ChTitle, Uri: info.ChUrl},
+			Published: published.UTC().Format(time.RFC3339),
+			Summary:   info.Text,
+		}
+		entry.Updated = entry.Published
+		if i, ok := index[entry.Id]; ok {
+			entry.Published = t.Entries[i].Published
+			entry.Updated = t.Entries[i].Updated
+			t.Entries[i] = entry
+		} else {
+			index[entry.Id] = len(t.Entries)
+			t.Entries = append(t.Entries, entry)
+		}
+	}
+	// RFC3339 in UTC sort as string
+	sort.SliceStable(t.Entries, func(i, j int) bool { return t.Entries[i].Published > t.Entries[j].Published })
+	t.Updated = now.UTC().Format(time.RFC3339)
+	return t
+}
+
+// Keep newest [max] entries. No limit if [max] <= 0.
+func (t *AtomFeed) Trim(max int) *AtomFeed {
+	if max > 0 && len(t.Entries) > max {
+		t.Entries = t.Entries[:max]
+	}
+	return t
+}
+
+func (t *AtomFeed) Bytes() (b []byte) {
+	b, t.Err = xml.MarshalIndent(t, "", "  ")
+	if t.Err == nil {
+		b = append([]byte(xml.Header), b...)
+	}
+	return b
+}
+
+// Write to [fileAtom] through a temp file, so readers never see a partial feed
+func (t *AtomFeed) Write(fileAtom string) *AtomFeed {
+	prefix := t.MyType + ".Write"
+	b := append(t.Bytes(), '\n')
+	tmp := fileAtom + ".tmp"
+	if t.Err == nil {
+		t.Err = os.MkdirAll(filepath.Dir(fileAtom), 0755)
+	}
+	if t.Err == nil {
+		t.Err = file.WriteByte(tmp, &b, 0644)
+	}
+	if t.Err == nil {
+		t.Err = os.Rename(tmp, fileAtom)
+	}
+	if t.Err != nil {
+		ezlog.Err().N(prefix).N(fileAtom).M(t.Err).Out()
+	}
+	return t
+}
+
+// Same id format as YouTube channel feed
+func atomId(info *YT_Info) string {
+	if id := YT_VideoId(info.Url); len(id) > 0 {
+		return "yt:video:" + id
+	}
+	return info.Url
+}
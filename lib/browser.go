@@ -0,0 +1,71 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"errors"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/J-Siu/go-dtquery/dq"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/runZeroInc/go-rod/lib/launcher"
+)
+
+// Time to wait for devtools of launched browser
+const BrowserWait = 30 * time.Second
+
+// Launch browser [bin] with user data [dir] and devtools [port], if devtools on [host]:[port] is not reachable.
+//
+// Browser is left running after exit. [bin] is searched if empty.
+func LaunchBrowser(bin, dir, host string, port int) (err error) {
+	prefix := "LaunchBrowser"
+	if dq.Get(host, port).Err == nil {
+		return nil
+	}
+	if len(bin) == 0 {
+		var found bool
+		if bin, found = launcher.LookPath(); !found {
+			return errors.New(prefix + ": browser not found, set Browser in config")
+		}
+	}
+	ezlog.Info().N(prefix).N(bin).N("dir").M(dir).N("port").M(port).Out()
+	browser := exec.Command(bin,
+		"--user-data-dir="+dir,
+		"--remote-debugging-port="+strconv.Itoa(port),
+		"--no-first-run")
+	if err = browser.Start(); err != nil {
+		return errors.New(prefix + ": " + err.Error())
+	}
+	if err = browser.Process.Release(); err != nil {
+		return errors.New(prefix + ": " + err.Error())
+	}
+	for deadline := time.Now().Add(BrowserWait); time.Now().Before(deadline); {
+		time.Sleep(500 * time.Millisecond)
+		if dq.Get(host, port).Err == nil {
+			return nil
+		}
+	}
+	return errors.New(prefix + ": devtools not reachable " + host + ":" + strconv.Itoa(port))
+}
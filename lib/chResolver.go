@@ -0,0 +1,377 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-helper/v2/file"
+	"github.com/runZeroInc/go-rod"
+	"github.com/runZeroInc/go-rod/lib/proto"
+)
+
+// Channel cache file name in state directory
+const FileChCache = "channel.json"
+
+// oEmbed endpoint, for channel of video without channel info, eg. shorts
+const (
+	YT_OEmbed     = "https://www.youtube.com/oembed?format=json&url="
+	OEmbedTimeout = 10 * time.Second
+)
+
+// Resolve channel handle <=> id <=> title, with local cache
+type ChResolver struct {
+	basestruct.Base
+
+	FileCache string    // cache file, no cache if empty
+	Page      *rod.Page // remote lookup opens a new tab in the browser of Page
+	Remote    bool      // look up channel not in cache by channel page, and channel of video by oEmbed. Cache only if false
+
+	byId     map[string]*YT_Channel
+	byPath   map[string]*YT_Channel // key: lower case "/@handle", "/c/name", etc
+	changed  bool
+	failed   map[string]bool // remote lookup failed, not retried
+	channels []*YT_Channel
+	videos   map[string]*YT_Channel // key: video id, channel by oEmbed, nil if failed
+}
+
+// Cache file content. Old cache file is a list of channels only.
+type chCache struct {
+	Channels []*YT_Channel          `json:"Channels"`
+	Videos   map[string]*YT_Channel `json:"Videos,omitempty"` // key: video id, channel by oEmbed
+}
+
+func (t *ChResolver) New(fileCache string, remote bool) *ChResolver {
+	t.Initialized = true
+	t.MyType = "ChResolver"
+	prefix := t.MyType + ".New"
+
+	t.FileCache = fileCache
+	t.Remote = remote
+
+	t.byId = make(map[string]*YT_Channel)
+	t.byPath = make(map[string]*YT_Channel)
+	t.failed = make(map[string]bool)
+	t.channels = nil
+	t.videos = make(map[string]*YT_Channel)
+	t.changed = false
+
+	t.load()
+	ezlog.Debug().N(prefix).N("FileCache").M(t.FileCache).N("channels").M(len(t.channels)).Out()
+	return t
+}
+
+// Add or merge [ch] into cache
+func (t *ChResolver) Add(ch *YT_Channel) *YT_Channel {
+	var cached *YT_Channel
+	if len(ch.Id) > 0 {
+		cached = t.byId[ch.Id]
+	}
+	if cached == nil && len(ch.Handle) > 0 {
+		cached = t.byPath[strings.ToLower("/"+ch.Handle)]
+	}
+	if cached == nil {
+		// Without id, only keep in memory
+		if len(ch.Id) == 0 {
+			return ch
+		}
+		cached = new(YT_Channel)
+		t.channels = append(t.channels, cached)
+	}
+	before := *cached
+	cached.Merge(ch)
+	if before.Handle != cached.Handle || before.Id != cached.Id || before.Title != cached.Title || len(before.Paths) != len(cached.Paths) {
+		t.changed = true
+	}
+	t.index(cached)
+	return cached
+}
+
+// Lookup by id
+func (t *ChResolver) ById(id string) *YT_Channel { return t.byId[id] }
+
+// Lookup by url path, eg. "/@handle", "/channel/<id>", "/c/name"
+func (t *ChResolver) ByPath(path string) (ch *YT_Channel) {
+	var tmp YT_Channel
+	tmp.SetPath(path)
+	if len(tmp.Id) > 0 {
+		return t.byId[tmp.Id]
+	}
+	return t.byPath[strings.ToLower("/"+strings.Trim(path, "/"))]
+}
+
+// Lookup by title. Return nil if title is not unique, title is not an identity.
+func (t *ChResolver) ByTitle(title string) (ch *YT_Channel) {
+	for _, c := range t.channels {
+		if c.Title == title {
+			if ch != nil {
+				return nil
+			}
+			ch = c
+		}
+	}
+	return ch
+}
+
+// Fill channel fields of [info]: ChId, ChTitle, ChUrl, ChUrlShort
+func (t *ChResolver) Resolve(info *YT_Info) *ChResolver {
+	prefix := t.MyType + ".Resolve"
+	var (
+		ch   *YT_Channel
+		path = info.ChUrlShort
+	)
+	if len(path) == 0 && len(info.ChUrl) > 0 {
+		if parsedUrl, err := url.Parse(info.ChUrl); err == nil {
+			path = UrlDecode(parsedUrl.Path)
+		}
+	}
+	if len(info.ChId) > 0 {
+		ch = t.ById(info.ChId)
+	}
+	if ch == nil && len(path) > 0 {
+		ch = t.ByPath(path)
+	}
+	if ch == nil && len(path) == 0 && len(info.ChId) == 0 && len(info.ChTitle) > 0 {
+		ch = t.ByTitle(info.ChTitle)
+	}
+	if ch == nil {
+		if len(info.ChId) > 0 {
+			ch = t.remote("/channel/" + info.ChId)
+		} else if len(path) > 0 {
+			ch = t.remote(path)
+		}
+	}
+	if ch != nil {
+		if len(info.ChId) == 0 {
+			info.ChId = ch.Id
+		}
+		if len(info.ChTitle) == 0 {
+			info.ChTitle = ch.Title
+		}
+		if urlShort := ch.UrlShort(); len(urlShort) > 0 {
+			info.ChUrlShort = urlShort
+			info.ChUrl = YT_FullUrl(urlShort)
+		}
+	}
+	ezlog.Trace().N(prefix).N("ChId").M(info.ChId).N("ChUrlShort").M(info.ChUrlShort).Out()
+	return t
+}
+
+// Same as [ChResolver.Resolve]. If [info] has no channel, eg. shorts, channel of its video is looked up by oEmbed first.
+// Result of oEmbed is kept in cache by video id. Lookup error is added to [info].
+func (t *ChResolver) ResolveVideo(ctx context.Context, info *YT_Info) *ChResolver {
+	if len(info.ChId)+len(info.ChUrl)+len(info.ChUrlShort) == 0 {
+		id := YT_VideoId(info.Url)
+		ch, ok := t.videos[id]
+		if !ok && len(id) > 0 && t.Remote && ctx.Err() == nil {
+			var err error
+			if ch, err = t.oEmbed(ctx, info.Url); err == nil {
+				t.changed = true
+			} else {
+				info.AddErr("channel", err)
+			}
+			if ctx.Err() == nil {
+				t.videos[id] = ch
+			}
+		}
+		if ch != nil {
+			info.ChTitle = ch.Title
+			info.ChUrlShort = ch.UrlShort()
+			info.ChUrl = YT_FullUrl(info.ChUrlShort)
+		}
+	}
+	return t.Resolve(info)
+}
+
+// Get channel title and path of video [urlStr] from oEmbed
+func (t *ChResolver) oEmbed(ctx context.Context, urlStr string) (ch *YT_Channel, err error) {
+	var (
+		data struct {
+			AuthorName string `json:"author_name"`
+			AuthorUrl  string `json:"author_url"`
+		}
+		parsedUrl *url.URL
+		req       *http.Request
+		res       *http.Response
+	)
+	client := http.Client{Timeout: OEmbedTimeout}
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, YT_OEmbed+url.QueryEscape(urlStr), nil)
+	if err == nil {
+		res, err = client.Do(req)
+	}
+	if err == nil {
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			err = errors.New("oembed: " + res.Status)
+		}
+	}
+	if err == nil {
+		err = json.NewDecoder(res.Body).Decode(&data)
+	}
+	if err == nil {
+		parsedUrl, err = url.Parse(data.AuthorUrl)
+	}
+	if err == nil && len(parsedUrl.Path) <= 1 {
+		err = errors.New("oembed: no author url")
+	}
+	if err != nil {
+		return nil, err
+	}
+	ch = &YT_Channel{Title: data.AuthorName}
+	ch.SetPath(UrlDecode(parsedUrl.Path))
+	return t.Add(ch), nil
+}
+
+// Write cache file if changed
+func (t *ChResolver) Save() *ChResolver {
+	prefix := t.MyType + ".Save"
+	if t.changed && len(t.FileCache) > 0 {
+		var (
+			b     []byte
+			cache = chCache{Channels: t.channels, Videos: make(map[string]*YT_Channel)}
+		)
+		sort.Slice(t.channels, func(i, j int) bool { return t.channels[i].Id < t.channels[j].Id })
+		for id, ch := range t.videos {
+			if ch != nil {
+				cache.Videos[id] = ch
+			}
+		}
+		b, t.Err = json.MarshalIndent(cache, "", "  ")
+		if t.Err == nil {
+			t.Err = os.MkdirAll(filepath.Dir(t.FileCache), 0755)
+		}
+		if t.Err == nil {
+			t.Err = file.WriteByte(t.FileCache, &b, 0644)
+		}
+		if t.Err == nil {
+			t.changed = false
+		} else {
+			ezlog.Err().N(prefix).M(t.Err).Out()
+		}
+	}
+	return t
+}
+
+func (t *ChResolver) index(ch *YT_Channel) {
+	if len(ch.Id) > 0 {
+		t.byId[ch.Id] = ch
+	}
+	if len(ch.Handle) > 0 {
+		t.byPath[strings.ToLower("/"+ch.Handle)] = ch
+	}
+	for _, p := range ch.Paths {
+		t.byPath[strings.ToLower(p)] = ch
+	}
+}
+
+func (t *ChResolver) load() {
+	prefix := t.MyType + ".load"
+	if len(t.FileCache) > 0 && file.IsRegularFile(t.FileCache) {
+		var (
+			b     *[]byte
+			cache chCache
+		)
+		b, t.Err = file.ReadByte(t.FileCache)
+		if t.Err == nil {
+			if t.Err = json.Unmarshal(*b, &cache); t.Err != nil {
+				// old cache file
+				t.Err = json.Unmarshal(*b, &cache.Channels)
+			}
+		}
+		if t.Err == nil {
+			for _, ch := range cache.Channels {
+				if len(ch.Id) > 0 {
+					t.channels = append(t.channels, ch)
+					t.index(ch)
+				}
+			}
+			for id, ch := range cache.Videos {
+				if ch == nil {
+					continue
+				}
+				if cached := t.ByPath(ch.UrlShort()); cached != nil {
+					ch = cached
+				}
+				t.videos[id] = ch
+			}
+		} else {
+			ezlog.Err().N(prefix).M(t.Err).Out()
+		}
+	}
+}
+
+// Lookup channel page in a new tab
+func (t *ChResolver) remote(path string) (ch *YT_Channel) {
+	prefix := t.MyType + ".remote"
+	if !t.Remote || t.Page == nil || t.failed[path] {
+		return nil
+	}
+	var (
+		data YTChannelMetadata
+		err  error
+		obj  *proto.RuntimeRemoteObject
+		page *rod.Page
+	)
+	ezlog.Debug().N(prefix).N("path").M(path).Out()
+	page, err = t.Page.Browser().Page(proto.TargetCreateTarget{URL: YT_FullUrl(path)})
+	if err == nil {
+		defer page.Close()
+		err = page.WaitLoad()
+	}
+	if err == nil {
+		obj, err = page.Eval(`() => JSON.stringify(ytInitialData.metadata.channelMetadataRenderer)`)
+	}
+	if err == nil {
+		err = json.Unmarshal([]byte(obj.Value.Str()), &data)
+	}
+	if err == nil && len(data.ExternalId) == 0 {
+		err = errors.New("channel id not found")
+	}
+	if err == nil {
+		var tmp = YT_Channel{
+			Id:    data.ExternalId,
+			Title: data.Title,
+		}
+		if parsedUrl, e := url.Parse(data.VanityChannelUrl); e == nil {
+			tmp.SetPath(UrlDecode(parsedUrl.Path))
+		}
+		tmp.SetPath(path)
+		ch = t.Add(&tmp)
+	} else {
+		t.failed[path] = true
+		ezlog.Err().N(prefix).N(path).M(err).Out()
+	}
+	t.Page.Activate()
+	return ch
+}
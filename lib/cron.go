@@ -0,0 +1,172 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron schedule
+//
+// Support "minute hour day-of-month month day-of-week" with `*`, `a-b`, `a,b`, `*/n`, `a-b/n`,
+// and descriptors "@hourly", "@daily", "@weekly", "@monthly", "@yearly", "@every <duration>".
+type Cron struct {
+	Every time.Duration // if > 0, run at fixed interval
+
+	fields  [5]uint64 // bit mask of minute, hour, dom, month, dow
+	domStar bool
+	dowStar bool
+}
+
+var cronRange = [5][2]int{
+	{0, 59}, // minute
+	{0, 23}, // hour
+	{1, 31}, // day of month
+	{1, 12}, // month
+	{0, 6},  // day of week, 0 = Sunday
+}
+
+var cronDescriptor = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+func (t *Cron) New(spec string) (*Cron, error) {
+	var err error
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		t.Every, err = time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err == nil && t.Every < time.Minute {
+			err = errors.New("interval less than 1m")
+		}
+		return t, err
+	}
+	if s, ok := cronDescriptor[spec]; ok {
+		spec = s
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return t, errors.New("cron: need 5 fields: " + spec)
+	}
+	for i, field := range fields {
+		if t.fields[i], err = cronField(field, cronRange[i][0], cronRange[i][1]); err != nil {
+			return t, errors.New("cron: " + spec + ": " + err.Error())
+		}
+	}
+	t.domStar = fields[2] == "*"
+	t.dowStar = fields[4] == "*"
+	return t, nil
+}
+
+// Return next schedule time after [after]
+func (t *Cron) Next(after time.Time) time.Time {
+	if t.Every > 0 {
+		return after.Add(t.Every)
+	}
+	next := after.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(5, 0, 0)
+	for next.Before(limit) {
+		if !cronBit(t.fields[3], int(next.Month())) {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if !t.dayMatch(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if !cronBit(t.fields[1], next.Hour()) {
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, next.Location())
+			continue
+		}
+		if !cronBit(t.fields[0], next.Minute()) {
+			next = next.Add(time.Minute)
+			continue
+		}
+		return next
+	}
+	return time.Time{}
+}
+
+// If both day of month and day of week are restricted, either one match
+func (t *Cron) dayMatch(date time.Time) bool {
+	dom := cronBit(t.fields[2], date.Day())
+	dow := cronBit(t.fields[4], int(date.Weekday()))
+	if t.domStar || t.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func cronBit(mask uint64, i int) bool { return mask&(1<<uint(i)) != 0 }
+
+func cronField(field string, min, max int) (mask uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		var (
+			lo, hi = min, max
+			step   = 1
+		)
+		rangeStr, stepStr, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 {
+				return 0, errors.New("bad step: " + part)
+			}
+		}
+		if rangeStr != "*" {
+			loStr, hiStr, hasRange := strings.Cut(rangeStr, "-")
+			if lo, err = strconv.Atoi(loStr); err != nil {
+				return 0, errors.New("bad value: " + part)
+			}
+			hi = lo
+			if hasRange {
+				if hi, err = strconv.Atoi(hiStr); err != nil {
+					return 0, errors.New("bad value: " + part)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		// day of week 7 = Sunday
+		if max == 6 && hi == 7 {
+			mask |= 1
+			hi = 6
+			if lo == 7 {
+				continue
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, errors.New("out of range: " + part)
+		}
+		for i := lo; i <= hi; i += step {
+			mask |= 1 << uint(i)
+		}
+	}
+	return mask, nil
+}
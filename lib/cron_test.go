@@ -0,0 +1,125 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronField(t *testing.T) {
+	tests := []struct {
+		field    string
+		min, max int
+		want     []int
+	}{
+		{"*", 0, 6, []int{0, 1, 2, 3, 4, 5, 6}},
+		{"5", 0, 59, []int{5}},
+		{"1-3", 1, 12, []int{1, 2, 3}},
+		{"*/15", 0, 59, []int{0, 15, 30, 45}},
+		{"10-20/5", 0, 59, []int{10, 15, 20}},
+		{"50/5", 0, 59, []int{50, 55}},
+		{"1,3,5", 0, 23, []int{1, 3, 5}},
+		{"1-2,10-11", 1, 31, []int{1, 2, 10, 11}},
+		{"7", 0, 6, []int{0}},
+		{"5-7", 0, 6, []int{0, 5, 6}},
+	}
+	for _, tt := range tests {
+		mask, err := cronField(tt.field, tt.min, tt.max)
+		if err != nil {
+			t.Errorf("cronField(%q): %v", tt.field, err)
+			continue
+		}
+		var want uint64
+		for _, i := range tt.want {
+			want |= 1 << uint(i)
+		}
+		if mask != want {
+			t.Errorf("cronField(%q) = %b, want %b", tt.field, mask, want)
+		}
+	}
+}
+
+func TestCronNewInvalid(t *testing.T) {
+	specs := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"1-b * * * *",
+		"@every 30s",
+		"@every x",
+		"@never",
+	}
+	for _, spec := range specs {
+		if _, err := new(Cron).New(spec); err == nil {
+			t.Errorf("New(%q): want error", spec)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// 2026-01-01 is Thursday
+	after := time.Date(2026, 1, 1, 10, 30, 20, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 1, 1, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"30 * * * *", time.Date(2026, 1, 1, 11, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)},
+		{"0 8 * * *", time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 1,5", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2-4 *", time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}}, // never
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// day of month or day of week if both restricted
+		{"0 0 15 * 6", time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"0 0 2 * 6", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", after.Add(90 * time.Minute)},
+	}
+	for _, tt := range tests {
+		cron, err := new(Cron).New(tt.spec)
+		if err != nil {
+			t.Errorf("New(%q): %v", tt.spec, err)
+			continue
+		}
+		if got := cron.Next(after); !got.Equal(tt.want) {
+			t.Errorf("Next(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+// Result of a command run by [Exec]
+type ExecResult struct {
+	Code     int // exit code, -1 if command cannot start or is killed
+	Duration time.Duration
+	Err      error
+	Info     *YT_Info
+}
+
+// Run a command template for each item of an info list, without shell.
+//
+// Placeholders in args are replaced, see [InfoExpand].
+type Exec struct {
+	basestruct.Base
+
+	Args    []string // command template
+	Jobs    int      // number of commands run in parallel
+	Results []ExecResult
+}
+
+func (t *Exec) New(cmdStr string, jobs int) *Exec {
+	t.Initialized = true
+	t.MyType = "Exec"
+	t.Args, t.Err = SplitArgs(cmdStr)
+	if t.Err == nil && len(t.Args) == 0 {
+		t.Err = errors.New("empty command")
+	}
+	t.Jobs = max(jobs, 1)
+	return t
+}
+
+// Run command for each item of [list] with url. Items not started are skipped when [ctx] is done.
+//
+// Output of commands goes to stdout/stderr directly if Jobs is 1, else it is printed when each command ends.
+func (t *Exec) Run(ctx context.Context, list *is.IInfoList) *Exec {
+	prefix := t.MyType + ".Run"
+	if t.Err != nil {
+		return t
+	}
+	var (
+		sem = make(chan struct{}, t.Jobs)
+		wg  sync.WaitGroup
+	)
+	t.Results = nil
+	for _, iinfo := range *list {
+		info, ok := iinfo.(*YT_Info)
+		if !ok || len(info.Url) == 0 {
+			continue
+		}
+		t.Results = append(t.Results, ExecResult{Code: -1, Info: info})
+	}
+	for i := range t.Results {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			t.Results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(result *ExecResult) {
+			defer func() { <-sem; wg.Done() }()
+			t.run(ctx, result)
+		}(&t.Results[i])
+	}
+	wg.Wait()
+	ezlog.Debug().N(prefix).N("count").M(len(t.Results)).N("failed").M(t.Failed()).Out()
+	return t
+}
+
+func (t *Exec) run(ctx context.Context, result *ExecResult) {
+	var args []string
+	for _, arg := range t.Args {
+		args = append(args, InfoExpand(arg, result.Info))
+	}
+	start := time.Now()
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if t.Jobs == 1 {
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		result.Err = cmd.Run()
+	} else {
+		var out []byte
+		out, result.Err = cmd.CombinedOutput()
+		ezlog.Log().N(result.Info.Url).M(strings.TrimSpace(string(out))).Out()
+	}
+	result.Duration = time.Since(start)
+	result.Code = cmd.ProcessState.ExitCode() // -1 if not started or killed
+}
+
+// Number of results with non-zero exit code
+func (t *Exec) Failed() (count int) {
+	for _, result := range t.Results {
+		if result.Code != 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// Print exit code report
+func (t *Exec) Print() *Exec {
+	ezlog.Log().M("Exit | Duration | Title | Url").Out()
+	ezlog.Log().M("--- | --- | --- | ---").Out()
+	for _, result := range t.Results {
+		ezlog.Log().
+			M(strconv.Itoa(result.Code) + " | " +
+				result.Duration.Round(time.Second).String() + " | " +
+				result.Info.Title + " | " +
+				result.Info.Url).
+			Out()
+	}
+	ezlog.Log().N("Total").M(len(t.Results)).N("Failed").M(t.Failed()).Out()
+	return t
+}
+
+// Split command line [s] into args. Supports single quote, double quote and backslash escape.
+func SplitArgs(s string) (args []string, err error) {
+	var (
+		arg    strings.Builder
+		escape bool
+		hasArg bool
+		quote  rune
+	)
+	for _, r := range s {
+		switch {
+		case escape:
+			arg.WriteRune(r)
+			escape = false
+		case r == '\\' && quote != '\'':
+			escape = true
+			hasArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			hasArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if hasArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				hasArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			hasArg = true
+		}
+	}
+	if escape || quote != 0 {
+		return nil, errors.New("unterminated quote or escape: " + s)
+	}
+	if hasArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,260 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/runZeroInc/go-rod"
+)
+
+// Extract fields from element [E] without panic. Errors are added to [Info].
+//
+// Child elements are not waited for.
+type Extract struct {
+	E    *rod.Element
+	Info *YT_Info
+}
+
+// Child [selector] of E, or E itself if [selector] is empty. Nil if not found.
+func (t *Extract) El(selector string) (e *rod.Element) {
+	if len(selector) == 0 {
+		return t.E
+	}
+	e, err := t.E.Element(selector)
+	if err != nil {
+		t.Info.AddErr(selector, err)
+		return nil
+	}
+	return e
+}
+
+// Trimmed text of child [selector], or E itself if [selector] is empty
+func (t *Extract) Text(selector string) string {
+	if e := t.El(selector); e != nil {
+		text, err := e.Text()
+		if err == nil {
+			return strings.TrimSpace(text)
+		}
+		t.Info.AddErr(selector, err)
+	}
+	return ""
+}
+
+// Attribute [name] of child [selector], or E itself if [selector] is empty
+func (t *Extract) Attr(selector, name string) string {
+	if e := t.El(selector); e != nil {
+		attr, err := e.Attribute(name)
+		if err == nil && attr == nil {
+			err = errors.New("no attribute " + name)
+		}
+		if err == nil {
+			return *attr
+		}
+		t.Info.AddErr(selector, err)
+	}
+	return ""
+}
+
+// Return true if [e] is visible, false on error
+func Visible(e *rod.Element) bool {
+	visible, err := e.Visible()
+	return err == nil && visible
+}
+
+// Count of elements with extraction error, and stop signal of processors
+type ElementStat struct {
+	Flagged int      // kept with partial info
+	Skipped int      // dropped, no info
+	Items   []string // description of flagged and skipped elements
+
+	Ctx         context.Context // processors stop at next element or scroll when done
+	Interrupted bool            // a processor stopped early by Ctx
+	Progress    *Progress       // counters shown on terminal if not nil
+	Overlay     *Dismisser      // overlays dismissed before scrolling if not nil
+}
+
+// Run V030 [f] of [p]. Panic in [f] is recovered.
+// Current element is flagged if its info has error, or skipped if it has no info.
+func (t *ElementStat) Guard(p *is.Processor, f func()) {
+	defer func() {
+		state := p.StateCurr
+		if r := recover(); r != nil {
+			err := PanicErr(r)
+			if info, ok := state.ElementInfo.(*YT_Info); ok && info != nil {
+				info.AddErr("", err)
+			} else {
+				t.Skipped++
+				t.Items = append(t.Items, "skipped "+p.MyType+" element "+strconv.Itoa(state.ElementIndex+1)+": "+err.Error())
+				ezlog.Warning().N(p.MyType).N("element").M(state.ElementIndex + 1).N("skipped").M(err).Out()
+			}
+		}
+		if info, ok := state.ElementInfo.(*YT_Info); ok && info != nil && len(info.Errs) > 0 {
+			t.Flag(p, info)
+		}
+	}()
+	f()
+}
+
+// Count current element of [p] as flagged, with errors of [info]
+func (t *ElementStat) Flag(p *is.Processor, info *YT_Info) {
+	index := p.StateCurr.ElementIndex + 1
+	t.Flagged++
+	t.Items = append(t.Items, "flagged "+p.MyType+" element "+strconv.Itoa(index)+": "+info.Title+" "+info.Url+": "+strings.Join(info.Errs, "; "))
+	ezlog.Warning().N(p.MyType).N("element").M(index).N("flagged").M(info.Errs).Out()
+}
+
+func (t *ElementStat) Total() int { return t.Flagged + t.Skipped }
+
+// Return [Ctx], or background context if not set
+func (t *ElementStat) Context() context.Context {
+	if t == nil || t.Ctx == nil {
+		return context.Background()
+	}
+	return t.Ctx
+}
+
+// Return true if [Ctx] is done
+func (t *ElementStat) Stopped() bool {
+	return t != nil && t.Ctx != nil && t.Ctx.Err() != nil
+}
+
+// Run [p]. Panic is returned in p.Err, info collected before panic is kept.
+//
+// When [stat] is stopped, remaining elements are not processed, V070 to V090 are not called for them, and page is not scrolled.
+// Element already being processed, eg. in delete menu, is finished.
+//
+// Info is written to [stream] if not nil, after it is matched.
+//
+// Counters of [stat] Progress are updated if not nil.
+//
+// Overlays are dismissed by [stat] Overlay, if not nil, before each scroll.
+func ProcessorRun(p *is.Processor, stat *ElementStat, stream *Stream) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.Err = errors.New(p.MyType + ": " + PanicErr(r).Error())
+			ezlog.Err().M(p.Err).Out()
+		}
+	}()
+	if stat != nil && stat.Ctx != nil {
+		v030 := p.V030_ElementInfo
+		p.V030_ElementInfo = func() {
+			if stat.Stopped() {
+				p.StateCurr.ElementInfo = nil
+				stat.interrupt(p)
+				return
+			}
+			v030()
+		}
+		// hooks after V030 are skipped for elements not processed
+		for _, f := range []*is.ProcessorFunc{&p.V070_ElementProcess, &p.V080_ElementScrollable, &p.V090_ElementLoopEnd} {
+			hook := *f
+			*f = func() {
+				if p.StateCurr.ElementInfo != nil || !stat.Stopped() {
+					hook()
+				}
+			}
+		}
+		scrollLoop := p.ScrollLoop
+		p.ScrollLoop = func() {
+			scrollLoop()
+			if p.StateCurr.ScrollPage && stat.Stopped() {
+				p.StateCurr.ScrollPage = false
+				stat.interrupt(p)
+			}
+		}
+	}
+	if stat != nil && stat.Overlay != nil {
+		scrollElement := p.ScrollElement
+		p.ScrollElement = func(element *rod.Element) {
+			stat.Overlay.Run()
+			scrollElement(element)
+		}
+	}
+	if stream != nil {
+		v070 := p.V070_ElementProcess
+		p.V070_ElementProcess = func() {
+			v070()
+			if p.StateCurr.ElementInfo != nil {
+				stream.Emit(p.StateCurr.ElementInfo)
+			}
+		}
+	}
+	if stat != nil && stat.Progress != nil {
+		progress := stat.Progress
+		loadPage := p.LoadPage
+		p.LoadPage = func() {
+			progress.Start(p.MyType, p.ScrollMax)
+			loadPage()
+		}
+		v030 := p.V030_ElementInfo
+		p.V030_ElementInfo = func() {
+			v030()
+			// history section has no title
+			if info, ok := p.StateCurr.ElementInfo.(*YT_Info); ok && info != nil && len(info.Title)+len(info.Url) > 0 {
+				progress.Update(func() { progress.Found++ })
+			}
+		}
+		v070 := p.V070_ElementProcess
+		p.V070_ElementProcess = func() {
+			v070()
+			if p.StateCurr.ElementInfo != nil && p.StateCurr.ElementInfo.Matched() {
+				progress.Update(func() { progress.Matched++ })
+			}
+		}
+		scrollLoop := p.ScrollLoop
+		p.ScrollLoop = func() {
+			scrollLoop()
+			progress.Update(func() {
+				progress.Processor = p.MyType
+				progress.Scroll = p.StateCurr.ScrollCount
+				if p.StateCurr.ScrollPage {
+					progress.Scroll++
+				}
+				progress.ScrollMax = p.ScrollMax
+			})
+		}
+	}
+	p.Run()
+}
+
+func (t *ElementStat) interrupt(p *is.Processor) {
+	if !t.Interrupted {
+		ezlog.Debug().N(p.MyType).M("interrupted").Out()
+	}
+	t.Interrupted = true
+}
+
+// Convert recovered value to error
+func PanicErr(r any) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return errors.New(fmt.Sprint(r))
+}
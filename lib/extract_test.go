@@ -0,0 +1,79 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"context"
+	"testing"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/runZeroInc/go-rod"
+)
+
+// History entries without browser: element [skip] has no info, Ctx is cancelled after element [cancel]
+func TestProcessorRunInterrupt(t *testing.T) {
+	tests := []struct {
+		name        string
+		skip        int
+		cancel      int
+		wantInfo    int
+		interrupted bool
+	}{
+		{"complete", -1, -1, 3, false},
+		{"skipped element", 1, -1, 2, false},
+		{"interrupted", -1, 0, 1, true},
+		{"skipped then interrupted", 0, 1, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			entry := new(IsHistoryEntry).New(&is.Property{Page: new(rod.Page)}, false, false, &[]string{}, false)
+			entry.NoPrint = true
+			entry.Stat.Ctx = ctx
+			entry.V020_Elements = func() {
+				entry.StateCurr.Elements = rod.Elements{new(rod.Element), new(rod.Element), new(rod.Element)}
+			}
+			entry.V030_ElementInfo = func() {
+				index := entry.StateCurr.ElementIndex
+				if index != tt.skip {
+					// no title, page is not checked for visibility
+					entry.StateCurr.ElementInfo = &YT_Info{Url: "/watch?v=" + string(rune('a'+index))}
+				}
+				if index == tt.cancel {
+					cancel()
+				}
+			}
+			entry.Run()
+			if entry.Err != nil {
+				t.Fatalf("Err = %v", entry.Err)
+			}
+			if got := len(*entry.IInfoList); got != tt.wantInfo {
+				t.Errorf("info = %d, want %d", got, tt.wantInfo)
+			}
+			if entry.Stat.Interrupted != tt.interrupted {
+				t.Errorf("Interrupted = %v, want %v", entry.Stat.Interrupted, tt.interrupted)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/J-Siu/go-helper/v2/str"
+)
+
+// Prefixes of filter term. Term without prefix is keyword in title, case insensitive.
+const (
+	FilterChannel = "ch:"   // channel @handle, id or title
+	FilterRegex   = "re:"   // regex of title, eg. "re:(?i)^go\b"
+	FilterType    = "type:" // video type, see [YT_Info.Type], or members
+)
+
+// Values of type filter
+var filterTypes = map[string]string{
+	"members":      YT_TypeMembers,
+	"members-only": YT_TypeMembers,
+	"live":         YT_TypeLive,
+	"premiere":     YT_TypePremiere,
+	"short":        YT_TypeShort,
+	"shorts":       YT_TypeShort,
+	"video":        YT_TypeVideo,
+}
+
+// Shorts policy, see [ShortsMatch]
+const (
+	ShortsExclude = "exclude"
+	ShortsInclude = "include" // default
+	ShortsOnly    = "only"
+)
+
+// Return false if [info] is excluded by shorts [policy]. Empty [policy] is [ShortsInclude].
+func ShortsMatch(info *YT_Info, policy string) bool {
+	switch policy {
+	case ShortsExclude:
+		return info.Type != YT_TypeShort
+	case ShortsOnly:
+		return info.Type == YT_TypeShort
+	}
+	return true
+}
+
+// Return error if [policy] is not a shorts policy
+func ShortsCheck(policy string) error {
+	switch policy {
+	case "", ShortsExclude, ShortsInclude, ShortsOnly:
+		return nil
+	}
+	return errors.New("unknown shorts policy: " + policy + ", use include, exclude or only")
+}
+
+// Match [info] with [include] and [exclude] filters, same as playlist filter.
+// All are matched if [include] is empty. [exclude] overrides [include].
+func FilterMatch(info *YT_Info, include, exclude *[]string) (matched bool, matchedStr string) {
+	matched = true
+	if include != nil && len(*include) != 0 {
+		matched, matchedStr = FilterAny(info, include)
+	}
+	if exclude != nil && len(*exclude) != 0 {
+		if excluded, excludedStr := FilterAny(info, exclude); excluded {
+			matched, matchedStr = false, excludedStr
+		}
+	}
+	return matched, matchedStr
+}
+
+// Return true and matching term if any of [terms] matches [info]
+func FilterAny(info *YT_Info, terms *[]string) (bool, string) {
+	for _, term := range *terms {
+		if filterTerm(info, term) {
+			return true, term
+		}
+	}
+	return false, ""
+}
+
+func filterTerm(info *YT_Info, term string) bool {
+	if ch, ok := strings.CutPrefix(term, FilterChannel); ok {
+		return ChMatch(info, ch)
+	}
+	if pattern, ok := strings.CutPrefix(term, FilterRegex); ok {
+		re, err := regexp.Compile(pattern)
+		return err == nil && re.MatchString(info.Title)
+	}
+	if typ, ok := strings.CutPrefix(term, FilterType); ok {
+		typ = filterTypes[strings.ToLower(typ)]
+		return typ == info.Type || (typ == YT_TypeMembers && info.Members)
+	}
+	return str.ContainsAnySubStringsBool(info.Title, &[]string{term}, false)
+}
+
+// Return error of invalid regex or type in [terms]
+func FilterCheck(terms *[]string) error {
+	var errList []error
+	for _, term := range *terms {
+		if pattern, ok := strings.CutPrefix(term, FilterRegex); ok {
+			if _, err := regexp.Compile(pattern); err != nil {
+				errList = append(errList, errors.New("filter "+term+": "+err.Error()))
+			}
+		}
+		if typ, ok := strings.CutPrefix(term, FilterType); ok {
+			if _, ok := filterTypes[strings.ToLower(typ)]; !ok {
+				errList = append(errList, errors.New("filter "+term+": unknown type, use video, short, live, premiere or members"))
+			}
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// Return true if channel of [info] is [ch], by @handle, channel id or title, case insensitive
+func ChMatch(info *YT_Info, ch string) bool {
+	ch = strings.TrimSpace(ch)
+	return len(ch) > 0 && (strings.EqualFold(ch, info.ChId) ||
+		strings.EqualFold(ch, info.ChTitle) ||
+		strings.EqualFold("/"+strings.TrimPrefix(ch, "/"), info.ChUrlShort))
+}
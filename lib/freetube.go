@@ -0,0 +1,225 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+// FreeTube profile id containing all subscriptions
+const FreeTubeProfileAll = "allChannels"
+
+// A line of FreeTube profiles.db
+type FreeTubeProfile struct {
+	Id            string            `json:"_id"`
+	Name          string            `json:"name"`
+	BgColor       string            `json:"bgColor"`
+	TextColor     string            `json:"textColor"`
+	Subscriptions []FreeTubeChannel `json:"subscriptions"`
+}
+
+type FreeTubeChannel struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+// A line of FreeTube playlists.db
+type FreeTubePlaylist struct {
+	Id            string          `json:"_id"`
+	PlaylistName  string          `json:"playlistName"`
+	Protected     bool            `json:"protected"`
+	Description   string          `json:"description"`
+	Videos        []FreeTubeVideo `json:"videos"`
+	CreatedAt     int64           `json:"createdAt"`
+	LastUpdatedAt int64           `json:"lastUpdatedAt"`
+}
+
+type FreeTubeVideo struct {
+	VideoId        string `json:"videoId"`
+	Title          string `json:"title"`
+	Author         string `json:"author"`
+	AuthorId       string `json:"authorId"`
+	LengthSeconds  int    `json:"lengthSeconds"`
+	TimeAdded      int64  `json:"timeAdded"`
+	PlaylistItemId string `json:"playlistItemId"`
+	Type           string `json:"type"`
+}
+
+// A playlist and its videos
+type PlaylistVideos struct {
+	Playlist *YT_Info
+	Videos   *is.IInfoList
+}
+
+// Export channels in [list] as FreeTube profiles.db with the "All Channels" profile.
+// Channels without channel id are skipped and returned in [skipped].
+func FreeTubeExportSubscriptions(list *is.IInfoList) (b []byte, skipped *is.IInfoList, err error) {
+	skipped = new(is.IInfoList)
+	profile := FreeTubeProfile{
+		Id:            FreeTubeProfileAll,
+		Name:          "All Channels",
+		BgColor:       "#000000",
+		TextColor:     "#FFFFFF",
+		Subscriptions: []FreeTubeChannel{},
+	}
+	for _, iinfo := range *list {
+		info := iinfo.(*YT_Info)
+		if len(info.ChId) == 0 {
+			*skipped = append(*skipped, info)
+			continue
+		}
+		profile.Subscriptions = append(profile.Subscriptions, FreeTubeChannel{Id: info.ChId, Name: info.ChTitle})
+	}
+	b, err = json.Marshal(&profile)
+	return b, skipped, err
+}
+
+// Export [playlists] as FreeTube playlists.db, one playlist per line
+func FreeTubeExportPlaylists(playlists []PlaylistVideos) (b []byte, err error) {
+	var (
+		buf bytes.Buffer
+		now = time.Now().UnixMilli()
+	)
+	for _, pv := range playlists {
+		playlist := FreeTubePlaylist{
+			Id:            "ft-playlist--" + randomId(),
+			PlaylistName:  pv.Playlist.Title,
+			Videos:        []FreeTubeVideo{},
+			CreatedAt:     now,
+			LastUpdatedAt: now,
+		}
+		for _, iinfo := range *pv.Videos {
+			info := iinfo.(*YT_Info)
+			id := YT_VideoId(info.Url)
+			if len(id) == 0 {
+				continue
+			}
+			playlist.Videos = append(playlist.Videos, FreeTubeVideo{
+				VideoId:        id,
+				Title:          info.Title,
+				Author:         info.ChTitle,
+				AuthorId:       info.ChId,
+				TimeAdded:      now,
+				PlaylistItemId: randomUUID(),
+				Type:           "video",
+			})
+		}
+		var line []byte
+		line, err = json.Marshal(&playlist)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// Import FreeTube profiles.db and/or playlists.db content.
+// Subscriptions of all profiles are merged by channel id.
+func FreeTubeImport(b []byte) (channels *is.IInfoList, playlists []PlaylistVideos, err error) {
+	var (
+		chSeen  = make(map[string]bool)
+		scanner = bufio.NewScanner(bytes.NewReader(b))
+	)
+	channels = new(is.IInfoList)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err = json.Unmarshal(line, &fields); err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case fields["subscriptions"] != nil:
+			var profile FreeTubeProfile
+			if err = json.Unmarshal(line, &profile); err != nil {
+				return nil, nil, err
+			}
+			for _, ch := range profile.Subscriptions {
+				if !chSeen[ch.Id] {
+					chSeen[ch.Id] = true
+					*channels = append(*channels, freeTubeChannelInfo(ch.Id, ch.Name))
+				}
+			}
+		case fields["playlistName"] != nil:
+			var playlist FreeTubePlaylist
+			if err = json.Unmarshal(line, &playlist); err != nil {
+				return nil, nil, err
+			}
+			pv := PlaylistVideos{
+				Playlist: &YT_Info{Title: playlist.PlaylistName, Text: playlist.Description},
+				Videos:   new(is.IInfoList),
+			}
+			for _, video := range playlist.Videos {
+				info := freeTubeChannelInfo(video.AuthorId, video.Author)
+				info.Title = video.Title
+				info.Url = YT_FullUrl("/watch?v=" + video.VideoId)
+				*pv.Videos = append(*pv.Videos, info)
+			}
+			playlists = append(playlists, pv)
+		}
+	}
+	if err = scanner.Err(); err == nil && len(*channels) == 0 && len(playlists) == 0 {
+		err = errors.New("not a FreeTube profiles.db or playlists.db")
+	}
+	return channels, playlists, err
+}
+
+func freeTubeChannelInfo(id, title string) *YT_Info {
+	info := YT_Info{ChId: id, ChTitle: title}
+	if len(id) > 0 {
+		info.ChUrlShort = "/channel/" + id
+		info.ChUrl = YT_FullUrl(info.ChUrlShort)
+	}
+	return &info
+}
+
+func randomId() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Random UUID v4
+func randomUUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	h := hex.EncodeToString(b)
+	return strings.Join([]string{h[0:8], h[8:12], h[12:16], h[16:20], h[20:]}, "-")
+}
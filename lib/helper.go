@@ -23,8 +23,12 @@ THE SOFTWARE.
 package lib
 
 import (
+	"errors"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/J-Siu/go-dtquery/dq"
 	"github.com/J-Siu/go-helper/v2/ezlog"
@@ -32,12 +36,12 @@ import (
 	"github.com/yosssi/gohtml"
 )
 
-func GetTab(host string, port int) (page *rod.Page) {
+// Connect to browser devtools at [host]:[port] and return first tab
+func GetTab(host string, port int) (page *rod.Page, err error) {
 	prefix := "GetTab"
 	ezlog.Debug().N(prefix).TxtStart().Out()
 	var (
 		browser *rod.Browser
-		err     error
 		pages   rod.Pages
 	)
 	devtools := dq.Get(host, port)
@@ -51,16 +55,19 @@ func GetTab(host string, port int) (page *rod.Page) {
 	}
 	if err == nil {
 		page = pages.First()
-		if page != nil {
-			page.Activate()
+		if page == nil {
+			err = errors.New("no tab in browser")
 		}
 	}
-
+	if err == nil {
+		_, err = page.Activate()
+	}
 	if err != nil {
-		ezlog.Err().N(prefix).M(err).Out()
+		page = nil
+		err = errors.New(prefix + ": " + err.Error())
 	}
 	ezlog.Debug().N(prefix).TxtEnd().Out()
-	return
+	return page, err
 }
 
 // log at trace level, format element html
@@ -76,7 +83,9 @@ func TraceElement(level ezlog.EzLogLevel, prefix, tag string, e *rod.Element) {
 		}
 		ezlog.Lm(e)
 		if e != nil {
-			ezlog.Lm(gohtml.Format(e.MustHTML()))
+			if html, err := e.HTML(); err == nil {
+				ezlog.Lm(gohtml.Format(html))
+			}
 		}
 		ezlog.Out()
 	}
@@ -90,12 +99,13 @@ func UrlDecode(urlIn string) (urlOut string) {
 	return
 }
 
-// Add YT base if missing, unescape query path
+// Add YT base if missing, unescape query path. Empty url stays empty.
 func YT_FullUrl(urlIn string) (urlOut string) {
 	var (
 		err error
 	)
-	if !strings.HasPrefix(urlIn, YT_Base) {
+	urlOut = urlIn
+	if len(urlIn) > 0 && !strings.HasPrefix(urlIn, YT_Base) {
 		urlOut, err = url.JoinPath(YT_Base, urlIn)
 		if err != nil {
 			urlOut = urlIn
@@ -103,3 +113,95 @@ func YT_FullUrl(urlIn string) (urlOut string) {
 	}
 	return UrlDecode(urlOut)
 }
+
+// Return video id of a watch or shorts url
+func YT_VideoId(urlIn string) (id string) {
+	parsedUrl, err := url.Parse(urlIn)
+	if err == nil {
+		id = parsedUrl.Query().Get("v")
+		if len(id) == 0 && strings.HasPrefix(parsedUrl.Path, "/shorts/") {
+			id = strings.TrimPrefix(parsedUrl.Path, "/shorts/")
+		}
+	}
+	return
+}
+
+// Return playlist id of a playlist url
+func YT_PlaylistId(urlIn string) (id string) {
+	parsedUrl, err := url.Parse(urlIn)
+	if err == nil {
+		id = parsedUrl.Query().Get("list")
+	}
+	return
+}
+
+// Return channel url from @handle, channel id, path or url. Channel tab is removed.
+func YT_ChannelUrl(ch string) (urlOut string) {
+	var (
+		path     string
+		segments []string
+	)
+	ch = strings.TrimSpace(ch)
+	switch {
+	case strings.HasPrefix(ch, "http://"), strings.HasPrefix(ch, "https://"):
+		parsedUrl, err := url.Parse(ch)
+		if err == nil {
+			path = parsedUrl.Path
+		}
+	case strings.HasPrefix(ch, "/"):
+		path = ch
+	case strings.HasPrefix(ch, "@"):
+		path = "/" + ch
+	case strings.HasPrefix(ch, "UC") && len(ch) == 24:
+		path = "/channel/" + ch
+	default:
+		path = "/@" + ch
+	}
+	segments = strings.Split(strings.Trim(path, "/"), "/")
+	switch segments[0] {
+	case "channel", "c", "user":
+		if len(segments) > 2 {
+			segments = segments[:2]
+		}
+	default:
+		segments = segments[:1]
+	}
+	return YT_FullUrl("/" + strings.Join(segments, "/"))
+}
+
+// Replace placeholders in [tmpl] with [info]: {url}, {title}, {channel}, {channel_id}, {channel_url}, {id}
+func InfoExpand(tmpl string, info *YT_Info) string {
+	return strings.NewReplacer(
+		"{url}", info.Url,
+		"{title}", info.Title,
+		"{channel}", info.ChTitle,
+		"{channel_id}", info.ChId,
+		"{channel_url}", info.ChUrl,
+		"{id}", YT_VideoId(info.Url),
+	).Replace(tmpl)
+}
+
+var reRelTime = regexp.MustCompile(`(\d+) (second|minute|hour|day|week|month|year)s? ago`)
+
+// Return time of relative date text before [now], eg. "3 hours ago", "Streamed 2 days ago".
+// Month is 30 days and year is 365 days.
+func YT_RelTime(text string, now time.Time) (t time.Time, ok bool) {
+	matches := reRelTime.FindStringSubmatch(text)
+	if len(matches) == 0 {
+		return t, false
+	}
+	n, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return t, false
+	}
+	unit := map[string]time.Duration{
+		"second": time.Second,
+		"minute": time.Minute,
+		"hour":   time.Hour,
+		"day":    24 * time.Hour,
+		"week":   7 * 24 * time.Hour,
+		"month":  30 * 24 * time.Hour,
+		"year":   365 * 24 * time.Hour,
+	}[matches[2]]
+	return now.Add(-time.Duration(n) * unit), true
+}
@@ -0,0 +1,233 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+const DateFormat = "2006-01-02"
+
+// Entry count of a key, eg. weekday or date
+type HistoryCount struct {
+	Key   string  `json:"Key"`
+	Count int     `json:"Count"`
+	Share float64 `json:"Share"` // percentage of total
+}
+
+// Entry count of a channel
+type HistoryChCount struct {
+	HistoryCount
+	ChId    string `json:"ChId,omitempty"`
+	ChTitle string `json:"ChTitle,omitempty"`
+	ChUrl   string `json:"ChUrl,omitempty"`
+	First   string `json:"First,omitempty"` // first seen section date
+	Last    string `json:"Last,omitempty"`  // last seen section date
+}
+
+// Aggregate history entries by channel, weekday and section date
+type HistoryStats struct {
+	basestruct.Base `json:"-"`
+
+	Total    int               `json:"Total"`
+	Channels []*HistoryChCount `json:"Channels"` // ranked by count
+	Weekdays []*HistoryCount   `json:"Weekdays"` // Monday to Sunday
+	Dates    []*HistoryCount   `json:"Dates"`    // latest first
+}
+
+func (t *HistoryStats) New(entries *is.IInfoList, now time.Time) *HistoryStats {
+	t.Initialized = true
+	t.MyType = "HistoryStats"
+	prefix := t.MyType + ".New"
+	var (
+		channels = make(map[string]*HistoryChCount)
+		dates    = make(map[string]*HistoryCount)
+		weekdays = make(map[time.Weekday]*HistoryCount)
+	)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		weekdays[day] = &HistoryCount{Key: day.String()}
+	}
+	for _, iinfo := range *entries {
+		info := iinfo.(*YT_Info)
+		if len(info.Title) == 0 {
+			continue
+		}
+		t.Total++
+		// -- channel
+		key := info.ChId
+		if len(key) == 0 {
+			key = info.ChUrlShort
+		}
+		if len(key) == 0 {
+			key = info.ChTitle
+		}
+		ch := channels[key]
+		if ch == nil {
+			ch = &HistoryChCount{
+				HistoryCount: HistoryCount{Key: key},
+				ChId:         info.ChId,
+				ChTitle:      info.ChTitle,
+				ChUrl:        info.ChUrl,
+			}
+			channels[key] = ch
+		}
+		ch.Count++
+		// -- date
+		date, ok := HistorySectionDate(info.Section, now)
+		if !ok {
+			ezlog.Debug().N(prefix).N("unknown section").M(info.Section).Out()
+			continue
+		}
+		dateStr := date.Format(DateFormat)
+		if len(ch.First) == 0 || dateStr < ch.First {
+			ch.First = dateStr
+		}
+		if dateStr > ch.Last {
+			ch.Last = dateStr
+		}
+		if dates[dateStr] == nil {
+			dates[dateStr] = &HistoryCount{Key: dateStr}
+		}
+		dates[dateStr].Count++
+		weekdays[date.Weekday()].Count++
+	}
+	// -- sort and share
+	for _, ch := range channels {
+		ch.Share = t.share(ch.Count)
+		t.Channels = append(t.Channels, ch)
+	}
+	sort.SliceStable(t.Channels, func(i, j int) bool {
+		if t.Channels[i].Count != t.Channels[j].Count {
+			return t.Channels[i].Count > t.Channels[j].Count
+		}
+		return t.Channels[i].ChTitle < t.Channels[j].ChTitle
+	})
+	for _, date := range dates {
+		date.Share = t.share(date.Count)
+		t.Dates = append(t.Dates, date)
+	}
+	sort.Slice(t.Dates, func(i, j int) bool { return t.Dates[i].Key > t.Dates[j].Key })
+	for day := time.Monday; day <= time.Saturday+1; day++ {
+		weekday := weekdays[day%7]
+		weekday.Share = t.share(weekday.Count)
+		t.Weekdays = append(t.Weekdays, weekday)
+	}
+	return t
+}
+
+// Print as markdown tables
+func (t *HistoryStats) Print() *HistoryStats {
+	ezlog.Log().M("## Channel").Out()
+	ezlog.Log().M("rank|count|share|channel|id|first|last").Out()
+	ezlog.Log().M("--|--|--|--|--|--|--").Out()
+	for i, ch := range t.Channels {
+		ezlog.Log().
+			M(strconv.Itoa(i+1) + "|" + strconv.Itoa(ch.Count) + "|" + shareStr(ch.Share) + "|" +
+				"[" + ch.ChTitle + "](" + ch.ChUrl + ")|" + ch.ChId + "|" + ch.First + "|" + ch.Last).
+			Out()
+	}
+	for _, table := range []struct {
+		name   string
+		counts []*HistoryCount
+	}{
+		{"Weekday", t.Weekdays},
+		{"Date", t.Dates},
+	} {
+		ezlog.Log().L().M("## " + table.name).Out()
+		ezlog.Log().M(strings.ToLower(table.name) + "|count|share").Out()
+		ezlog.Log().M("--|--|--").Out()
+		for _, c := range table.counts {
+			ezlog.Log().M(c.Key + "|" + strconv.Itoa(c.Count) + "|" + shareStr(c.Share)).Out()
+		}
+	}
+	return t
+}
+
+// Print as json
+func (t *HistoryStats) PrintJson() *HistoryStats {
+	var b []byte
+	b, t.Err = json.MarshalIndent(t, "", "  ")
+	if t.Err == nil {
+		ezlog.Log().M(string(b)).Out()
+	}
+	return t
+}
+
+func (t *HistoryStats) share(count int) float64 {
+	if t.Total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)*10000/float64(t.Total)) / 100
+}
+
+func shareStr(share float64) string {
+	return strconv.FormatFloat(share, 'f', 2, 64) + "%"
+}
+
+// Convert history section title to date. eg. "Today", "Yesterday", "Monday", "Oct 3", "Oct 3, 2024"
+func HistorySectionDate(title string, now time.Time) (date time.Time, ok bool) {
+	var (
+		err   error
+		today = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	)
+	title = strings.TrimSpace(title)
+	switch strings.ToLower(title) {
+	case "today":
+		return today, true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true
+	}
+	// weekday within last 7 days
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(title, day.String()) {
+			diff := (int(today.Weekday()) - int(day) + 7) % 7
+			if diff == 0 {
+				diff = 7
+			}
+			return today.AddDate(0, 0, -diff), true
+		}
+	}
+	for _, layout := range []string{"Jan 2, 2006", "January 2, 2006"} {
+		if date, err = time.ParseInLocation(layout, title, now.Location()); err == nil {
+			return date, true
+		}
+	}
+	// without year, in the past: this year, else last year. eg. "Feb 29" is not valid in every year.
+	for _, year := range []int{today.Year(), today.Year() - 1} {
+		for _, layout := range []string{"Jan 2, 2006", "January 2, 2006"} {
+			if date, err = time.ParseInLocation(layout, title+", "+strconv.Itoa(year), now.Location()); err == nil && !date.After(today) {
+				return date, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
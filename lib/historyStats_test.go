@@ -0,0 +1,69 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHistorySectionDate(t *testing.T) {
+	// 2024-03-05 is Tuesday, 2024 is leap year
+	now := time.Date(2024, 3, 5, 15, 4, 5, 0, time.UTC)
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		title string
+		now   time.Time
+		want  time.Time // zero if not a date
+	}{
+		{"Today", now, day(2024, 3, 5)},
+		{"today", now, day(2024, 3, 5)},
+		{"Yesterday", now, day(2024, 3, 4)},
+		{"Monday", now, day(2024, 3, 4)},
+		{"Sunday", now, day(2024, 3, 3)},
+		{"Wednesday", now, day(2024, 2, 28)},
+		{"Tuesday", now, day(2024, 2, 27)},
+		{" friday ", now, day(2024, 3, 1)},
+		{"Oct 3, 2023", now, day(2023, 10, 3)},
+		{"October 3, 2023", now, day(2023, 10, 3)},
+		{"Mar 5", now, day(2024, 3, 5)},
+		{"Mar 6", now, day(2023, 3, 6)},
+		{"December 25", now, day(2023, 12, 25)},
+		{"Jan 1", now, day(2024, 1, 1)},
+		{"Feb 29", now, day(2024, 2, 29)},
+		{"Feb 29", day(2024, 2, 28), time.Time{}}, // 2023 has no Feb 29
+		{"Feb 29", day(2025, 3, 1), day(2024, 2, 29)},
+		{"Feb 28", day(2025, 3, 1), day(2025, 2, 28)},
+		{"Feb 30", now, time.Time{}},
+		{"This week", now, time.Time{}},
+		{"", now, time.Time{}},
+	}
+	for _, tt := range tests {
+		got, ok := HistorySectionDate(tt.title, tt.now)
+		if ok != !tt.want.IsZero() || !got.Equal(tt.want) {
+			t.Errorf("HistorySectionDate(%q, %s) = %s, %v, want %s", tt.title, tt.now.Format(time.DateOnly), got, ok, tt.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/runZeroInc/go-rod"
+)
+
+// Process videos, shorts or live tab of a YT channel
+type IsChannelVideo struct {
+	IsSubVideo
+	Channel YT_Info // channel info of the page
+}
+
+func (t *IsChannelVideo) New(page *rod.Page, urlStr string, scrollMax int, day uint) *IsChannelVideo {
+	t.IsSubVideo.New(page, urlStr, scrollMax, day) // Init the base struct
+	t.MyType = "IsChannelVideo"
+	// channel page items have no channel info
+	t.chDefault = &t.Channel
+	t.override()
+	return t
+}
+
+func (t *IsChannelVideo) Run() *IsChannelVideo {
+	ProcessorRun(t.Processor, t.Stat, t.Stream)
+	return t
+}
+
+func (t *IsChannelVideo) override() {
+	t.V010_Container = t.override_V010_Container
+}
+
+// Get channel info from page
+func (t *IsChannelVideo) override_V010_Container() {
+	prefix := t.MyType + ".V010_Container"
+	t.StateCurr.Name = prefix
+	var data YTChannelMetadata
+	// channel info is optional, error not kept in t.Err
+	obj, err := t.Page.Eval(`() => JSON.stringify(ytInitialData.metadata.channelMetadataRenderer)`)
+	if err == nil {
+		err = json.Unmarshal([]byte(obj.Value.String()), &data)
+	}
+	if err == nil {
+		t.Channel.ChId = data.ExternalId
+		t.Channel.ChTitle = data.Title
+		t.Channel.ChUrl = YT_FullUrl(data.ChannelUrl)
+		parsedUrl, err := url.Parse(data.VanityChannelUrl)
+		if err == nil && len(parsedUrl.Path) > 0 {
+			t.Channel.ChUrlShort = UrlDecode(parsedUrl.Path)
+			t.Channel.ChUrl = YT_FullUrl(t.Channel.ChUrlShort)
+		}
+		if t.Resolver != nil {
+			ch := YT_Channel{Id: t.Channel.ChId, Title: t.Channel.ChTitle}
+			ch.SetPath(t.Channel.ChUrlShort)
+			t.Resolver.Add(&ch)
+		}
+	} else {
+		ezlog.Err().N(prefix).M(err).Out()
+	}
+	ezlog.Debug().N(prefix).N("Channel").Lm(t.Channel).Out()
+}
@@ -35,12 +35,19 @@ type IsHistorySection struct {
 
 	Del         bool // false;
 	Deleted     bool // false;
-	Desc        bool // false;
+	Desc        bool // include description of entries
+	NoPrint     bool // false; no printing of entries
 	PrintHeader bool // true;
 	Remove      bool // false;
 	Standalone  bool // false;
 	Verbose     bool // false;
 	Filter      []string
+	Shorts      string // shorts policy of entries, see [ShortsMatch]. Default: exclude, shorts are not deleted
+
+	Entries  *is.IInfoList // If not nil, entries of all sections are added to it
+	Resolver *ChResolver   // resolve channel of entries if not nil
+	Stat     *ElementStat  // element extraction errors of entries
+	Stream   *Stream       // write entries as extracted if not nil
 }
 
 func (t *IsHistorySection) New(page *rod.Page, urlStr string, remove bool, scrollMax int, verbose bool) *IsHistorySection {
@@ -54,7 +61,10 @@ func (t *IsHistorySection) New(page *rod.Page, urlStr string, remove bool, scrol
 	t.Processor = is.New(&property) // Init the base struct
 	t.MyType = "IsHistorySection"
 
+	t.PrintHeader = true
 	t.Remove = remove
+	t.Shorts = ShortsExclude
+	t.Stat = new(ElementStat)
 	t.Verbose = verbose
 	t.override()
 
@@ -62,7 +72,7 @@ func (t *IsHistorySection) New(page *rod.Page, urlStr string, remove bool, scrol
 }
 
 func (t *IsHistorySection) Run() *IsHistorySection {
-	t.Processor.Run()
+	ProcessorRun(t.Processor, t.Stat, nil)
 	return t
 }
 
@@ -77,11 +87,19 @@ func (t *IsHistorySection) override_V020_Elements() {
 	prefix := t.MyType + ".V020_Elements"
 	t.StateCurr.Name = prefix
 	tagName := "ytd-item-section-renderer"
-	section := t.Page.MustElement(tagName) // necessary?
-	ezlog.Trace().N(prefix).N("MustWaitDOMStable").TxtStart().Out()
-	section.MustWaitVisible()
-	ezlog.Trace().N(prefix).N("MustWaitDOMStable").TxtEnd().Out()
-	t.StateCurr.Elements = t.Page.MustElements(tagName)
+	var section *rod.Element
+	section, t.Err = t.Page.Element(tagName) // necessary?
+	if t.Err == nil {
+		ezlog.Trace().N(prefix).N("WaitVisible").TxtStart().Out()
+		t.Err = section.WaitVisible()
+		ezlog.Trace().N(prefix).N("WaitVisible").TxtEnd().Out()
+	}
+	if t.Err == nil {
+		t.StateCurr.Elements, t.Err = t.Page.Elements(tagName)
+	}
+	if t.Err != nil {
+		ezlog.Err().N(prefix).M(t.Err).Out()
+	}
 	ezlog.Trace().N(prefix).N(tagName).N("element count").M(len(t.StateCurr.Elements)).Out()
 }
 
@@ -100,9 +118,15 @@ func (t *IsHistorySection) override_V030_ElementInfo() {
 		}
 
 		for j, item := range elements {
-			title := item.MustText()
-			tmp := "## Section[" + strany.Any(t.StateCurr.ElementIndex) + "] Title[" + strany.Any(j) + "]"
-			ezlog.Log().L().N(tmp).M(title).Out()
+			title, err := item.Text()
+			if err != nil {
+				ezlog.Err().N(prefix).M(err).Out()
+				continue
+			}
+			if t.PrintHeader {
+				tmp := "## Section[" + strany.Any(t.StateCurr.ElementIndex) + "] Title[" + strany.Any(j) + "]"
+				ezlog.Log().L().N(tmp).M(title).Out()
+			}
 			info.Titles = append(info.Titles, title)
 		}
 		if len(info.Titles) == 0 {
@@ -115,8 +139,9 @@ func (t *IsHistorySection) override_V030_ElementInfo() {
 func (t *IsHistorySection) override_V070_ElementProcess() {
 	prefix := t.MyType + ".V070_ElementProcess"
 	t.StateCurr.Name = prefix
-	titles := t.StateCurr.ElementInfo.(*YT_Info).Titles
-	if len(titles) != 0 && len((titles)[0]) != 0 {
+	// skipped element has no info
+	info, _ := t.StateCurr.ElementInfo.(*YT_Info)
+	if info != nil && len(info.Titles) != 0 && len(info.Titles[0]) != 0 {
 		var (
 			isHistoryEntry IsHistoryEntry
 			property       = is.Property{
@@ -125,7 +150,18 @@ func (t *IsHistorySection) override_V070_ElementProcess() {
 			}
 		)
 		isHistoryEntry.
-			New(&property, t.Del, t.Remove, &t.Filter, t.Verbose).Run()
+			New(&property, t.Del, t.Remove, &t.Filter, t.Verbose)
+		isHistoryEntry.Desc = t.Desc
+		isHistoryEntry.NoPrint = t.NoPrint
+		isHistoryEntry.Resolver = t.Resolver
+		isHistoryEntry.Section = info.Titles[0]
+		isHistoryEntry.Shorts = t.Shorts
+		isHistoryEntry.Stat = t.Stat
+		isHistoryEntry.Stream = t.Stream
+		isHistoryEntry.Run()
+		if t.Entries != nil {
+			*t.Entries = append(*t.Entries, *isHistoryEntry.IInfoList...)
+		}
 	}
 }
 
@@ -144,14 +180,16 @@ func (t *IsHistorySection) override_V100_ScrollLoopEnd() {
 		t.StateCurr.ScrollableElement = nil
 	}
 	t.StateCurr.Scroll = true
-	ezlog.Trace().N(prefix).N("MustWaitLoad").TxtStart().Out()
-	t.Page.MustWaitLoad()
-	ezlog.Trace().N(prefix).N("MustWaitLoad").TxtEnd().Out()
+	ezlog.Trace().N(prefix).N("WaitLoad").TxtStart().Out()
+	if err := t.Page.WaitLoad(); err != nil {
+		ezlog.Err().N(prefix).M(err).Out()
+	}
+	ezlog.Trace().N(prefix).N("WaitLoad").TxtEnd().Out()
 }
 
 func (t *IsHistorySection) removeSpinningWheel() {
-	es := t.Page.MustElements("ytd-continuation-item-renderer") // tag name
-	if es != nil {
+	es, err := t.Page.Elements("ytd-continuation-item-renderer") // tag name
+	if err == nil {
 		count := len(es)
 		var removed int
 		for _, e := range es {
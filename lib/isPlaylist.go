@@ -23,6 +23,8 @@ THE SOFTWARE.
 package lib
 
 import (
+	"errors"
+
 	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/go-helper/v2/str"
 	"github.com/J-Siu/go-is/v3/is"
@@ -32,8 +34,10 @@ import (
 type IsPlaylist struct {
 	*is.Processor
 
-	Exclude *[]string `json:"Exclude"`
-	Include *[]string `json:"Include"`
+	Exclude *[]string    `json:"Exclude"`
+	Include *[]string    `json:"Include"`
+	Stat    *ElementStat `json:"-"` // element extraction errors
+	Stream  *Stream      `json:"-"` // write info as extracted if not nil
 }
 
 func (t *IsPlaylist) New(page *rod.Page, urlStr string, scrollMax int, exclude *[]string, include *[]string) *IsPlaylist {
@@ -52,6 +56,7 @@ func (t *IsPlaylist) New(page *rod.Page, urlStr string, scrollMax int, exclude *
 	}
 	t.Exclude = exclude
 	t.Include = include
+	t.Stat = new(ElementStat)
 
 	ezlog.Debug().N(prefix).Lm(t).Out()
 	t.override()
@@ -60,14 +65,14 @@ func (t *IsPlaylist) New(page *rod.Page, urlStr string, scrollMax int, exclude *
 }
 
 func (t *IsPlaylist) Run() *IsPlaylist {
-	t.Processor.Run()
+	ProcessorRun(t.Processor, t.Stat, t.Stream)
 	return t
 }
 
 func (t *IsPlaylist) override() {
 	t.V010_Container = t.override_V010_Container
 	t.V020_Elements = t.override_V020_Elements
-	t.V030_ElementInfo = t.override_V030_ElementInfo
+	t.V030_ElementInfo = func() { t.Stat.Guard(t.Processor, t.override_V030_ElementInfo) }
 	t.V040_ElementMatch = t.override_V040_ElementMatch
 }
 
@@ -75,7 +80,11 @@ func (t *IsPlaylist) override_V010_Container() {
 	prefix := t.MyType + ".V010_ElementsContainer"
 	t.StateCurr.Name = prefix
 	byId := "#contents"
-	t.Container = t.Page.MustElement(byId) // by id
+	t.Container, t.Err = t.Page.Element(byId) // by id
+	if t.Err != nil {
+		ezlog.Err().N(prefix).M(t.Err).Out()
+		return
+	}
 	TraceElement(ezlog.TRACE, prefix, "", t.Container)
 }
 
@@ -83,7 +92,9 @@ func (t *IsPlaylist) override_V020_Elements() {
 	prefix := t.MyType + ".V020_Elements"
 	t.StateCurr.Name = prefix
 	tagName := "ytd-rich-item-renderer"
-	t.StateCurr.Elements = t.Container.MustElements(tagName)
+	if t.Container != nil {
+		t.StateCurr.Elements, t.Err = t.Container.Elements(tagName)
+	}
 	ezlog.Debug().N(prefix).N(tagName).N("element count").M(len(t.StateCurr.Elements)).Out()
 }
 
@@ -92,21 +103,22 @@ func (t *IsPlaylist) override_V030_ElementInfo() {
 	t.StateCurr.Name = prefix
 	if t.StateCurr.Element != nil {
 		var info YT_Info
+		t.StateCurr.ElementInfo = &info
 		TraceElement(ezlog.TRACE, prefix, "", t.StateCurr.Element)
-		h3 := t.StateCurr.Element.MustElement("h3")
-		if h3 != nil {
-			info.Title = *(h3.MustAttribute("title"))
-			ezlog.Debug().N(prefix).N("Title").M(info.Title).Out()
-		}
+		x := Extract{E: t.StateCurr.Element, Info: &info}
+		info.Title = x.Attr("h3", "title")
+		ezlog.Debug().N(prefix).N("Title").M(info.Title).Out()
 		tagName := "a"
-		es := t.StateCurr.Element.MustElements(tagName)
+		es, _ := t.StateCurr.Element.Elements(tagName)
 		for _, s := range es {
-			if s.MustText() == "View full playlist" {
+			if text, err := s.Text(); err == nil && text == "View full playlist" {
 				TraceElement(ezlog.TRACE, prefix, "", s)
-				info.Url = YT_FullUrl(*s.MustAttribute("href"))
+				info.Url = YT_FullUrl((&Extract{E: s, Info: &info}).Attr("", "href"))
 			}
 		}
-		t.StateCurr.ElementInfo = &info
+		if len(info.Url) == 0 {
+			info.AddErr("url", errors.New("no playlist link"))
+		}
 	}
 }
 
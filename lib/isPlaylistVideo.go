@@ -30,6 +30,9 @@ import (
 
 type IsPlaylistVideo struct {
 	*is.Processor
+	Resolver *ChResolver  // resolve channel if not nil
+	Stat     *ElementStat // element extraction errors
+	Stream   *Stream      // write info as extracted if not nil
 }
 
 func (t *IsPlaylistVideo) New(page *rod.Page, urlStr string, scrollMax int) *IsPlaylistVideo {
@@ -42,6 +45,7 @@ func (t *IsPlaylistVideo) New(page *rod.Page, urlStr string, scrollMax int) *IsP
 	}
 	t.Processor = is.New(&property) // Init the base struct
 	t.MyType = "IsPlaylistVideo"
+	t.Stat = new(ElementStat)
 
 	t.override()
 
@@ -49,21 +53,24 @@ func (t *IsPlaylistVideo) New(page *rod.Page, urlStr string, scrollMax int) *IsP
 }
 
 func (t *IsPlaylistVideo) Run() *IsPlaylistVideo {
-	t.Processor.Run()
+	ProcessorRun(t.Processor, t.Stat, t.Stream)
 	return t
 }
 
 func (t *IsPlaylistVideo) override() {
 	t.V010_Container = t.override_V010_Container
 	t.V020_Elements = t.override_V020_Elements
-	t.V030_ElementInfo = t.override_V030_ElementInfo
+	t.V030_ElementInfo = func() { t.Stat.Guard(t.Processor, t.override_V030_ElementInfo) }
 }
 
 func (t *IsPlaylistVideo) override_V010_Container() {
 	prefix := t.MyType + ".V010_ElementsContainer"
 	t.StateCurr.Name = prefix
 	tagName := "ytd-playlist-video-list-renderer"
-	t.Container = t.Page.MustElement(tagName)
+	t.Container, t.Err = t.Page.Element(tagName)
+	if t.Err != nil {
+		ezlog.Err().N(prefix).M(t.Err).Out()
+	}
 	ezlog.Debug().N(prefix).N(tagName).Lm(t.Container).Out()
 }
 
@@ -71,7 +78,9 @@ func (t *IsPlaylistVideo) override_V020_Elements() {
 	prefix := t.MyType + ".V020_Elements"
 	t.StateCurr.Name = prefix
 	tagName := "ytd-playlist-video-renderer"
-	t.StateCurr.Elements = t.Container.MustElements(tagName)
+	if t.Container != nil {
+		t.StateCurr.Elements, t.Err = t.Container.Elements(tagName)
+	}
 	ezlog.Debug().N(prefix).N(tagName).N("count").M(len(t.StateCurr.Elements)).Out()
 }
 
@@ -80,9 +89,19 @@ func (t *IsPlaylistVideo) override_V030_ElementInfo() {
 	t.StateCurr.Name = prefix
 	if t.StateCurr.Element != nil {
 		var info YT_Info
-		e := t.StateCurr.Element.MustElement("#video-title")
-		info.Title = e.MustText()
-		info.Url = YT_FullUrl(*e.MustAttribute("href"))
 		t.StateCurr.ElementInfo = &info
+		x := Extract{E: t.StateCurr.Element, Info: &info}
+		info.Title = x.Text("#video-title")
+		info.Url = YT_FullUrl(x.Attr("#video-title", "href"))
+		// channel is optional, eg. deleted video
+		if a, err := t.StateCurr.Element.Element("#channel-name a"); err == nil {
+			xa := Extract{E: a, Info: &info}
+			info.ChTitle = xa.Text("")
+			info.ChUrlShort = UrlDecode(xa.Attr("", "href"))
+			info.ChUrl = YT_FullUrl(info.ChUrlShort)
+		}
+		if t.Resolver != nil {
+			t.Resolver.Resolve(&info)
+		}
 	}
 }
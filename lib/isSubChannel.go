@@ -24,7 +24,6 @@ package lib
 
 import (
 	"encoding/json"
-	"strings"
 
 	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/go-is/v3/is"
@@ -33,7 +32,9 @@ import (
 
 type IsSubChannel struct {
 	*is.Processor
-	TitleId ChTitleID
+	Resolver *ChResolver  // resolve channel id, in memory only if nil
+	Stat     *ElementStat // element extraction errors
+	Stream   *Stream      // write info as extracted if not nil
 }
 
 func (t *IsSubChannel) New(page *rod.Page, urlStr string, scrollMax int) *IsSubChannel {
@@ -46,34 +47,49 @@ func (t *IsSubChannel) New(page *rod.Page, urlStr string, scrollMax int) *IsSubC
 	}
 	t.Processor = is.New(&property) // Init the base struct
 	t.MyType = "IsSubChannel"
-
-	t.TitleId = make(ChTitleID)
+	t.Stat = new(ElementStat)
 
 	t.override()
 	return t
 }
 
 func (t *IsSubChannel) Run() *IsSubChannel {
-	t.Processor.Run()
+	ProcessorRun(t.Processor, t.Stat, t.Stream)
 	return t
 }
 
 func (t *IsSubChannel) override() {
 	t.V010_Container = t.override_V010_Container
 	t.V020_Elements = t.override_V020_Elements
-	t.V030_ElementInfo = t.override_V030_ElementInfo
+	t.V030_ElementInfo = func() { t.Stat.Guard(t.Processor, t.override_V030_ElementInfo) }
 }
 
 func (t *IsSubChannel) override_V010_Container() {
 	prefix := t.MyType + ".V010_Container"
 	t.StateCurr.Name = prefix
 
+	if t.Resolver == nil {
+		t.Resolver = new(ChResolver).New("", false)
+	}
 	var data YTInitialData
-	j := t.Page.MustEval(`() => JSON.stringify(ytInitialData)`).String()
-	if t.Err = json.Unmarshal([]byte(j), &data); t.Err == nil {
-		t.TitleId.New(&data)
+	obj, err := t.Page.Eval(`() => JSON.stringify(ytInitialData)`)
+	if err == nil {
+		err = json.Unmarshal([]byte(obj.Value.String()), &data)
+	}
+	// channel list is optional, channels are still resolved from cache or remote
+	if err != nil {
+		ezlog.Err().N(prefix).M(err).Out()
+	} else {
+		channels, err := data.Channels()
+		if err != nil {
+			// title is also extracted from element
+			ezlog.Warning().N(prefix).M(err).Out()
+		}
+		for i := range channels {
+			t.Resolver.Add(&channels[i])
+		}
 		if ezlog.GetLogLevel() == ezlog.TRACE {
-			ezlog.Trace().N(prefix).Nl("TitleId").M(t.TitleId).Out()
+			ezlog.Trace().N(prefix).Nl("channels").M(channels).Out()
 		}
 	}
 }
@@ -81,8 +97,17 @@ func (t *IsSubChannel) override_V010_Container() {
 func (t *IsSubChannel) override_V020_Elements() {
 	prefix := t.MyType + ".V020_Elements"
 	t.StateCurr.Name = prefix
-	t.Page.MustElement("#content-section").MustWaitVisible()
-	t.StateCurr.Elements = t.Page.MustElements("#content-section")
+	tagName := "#content-section"
+	var e *rod.Element
+	if e, t.Err = t.Page.Element(tagName); t.Err == nil {
+		t.Err = e.WaitVisible()
+	}
+	if t.Err == nil {
+		t.StateCurr.Elements, t.Err = t.Page.Elements(tagName)
+	}
+	if t.Err != nil {
+		ezlog.Err().N(prefix).M(t.Err).Out()
+	}
 }
 
 func (t *IsSubChannel) override_V030_ElementInfo() {
@@ -90,10 +115,12 @@ func (t *IsSubChannel) override_V030_ElementInfo() {
 	t.StateCurr.Name = prefix
 	if t.StateCurr.Element != nil {
 		var info YT_Info
-		info.ChTitle = strings.TrimSpace(t.StateCurr.Element.MustElement("#text").MustText())
-		info.ChId = string(t.TitleId[info.ChTitle])
-		info.ChUrl = YT_FullUrl(*t.StateCurr.Element.MustElement("#main-link").MustAttribute("href"))
-		TraceElement(ezlog.TRACE, prefix, "", t.StateCurr.Element)
 		t.StateCurr.ElementInfo = &info
+		x := Extract{E: t.StateCurr.Element, Info: &info}
+		info.ChTitle = x.Text("#text")
+		info.ChUrlShort = UrlDecode(x.Attr("#main-link", "href"))
+		info.ChUrl = YT_FullUrl(info.ChUrlShort)
+		t.Resolver.Resolve(&info)
+		TraceElement(ezlog.TRACE, prefix, "", t.StateCurr.Element)
 	}
 }
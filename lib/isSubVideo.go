@@ -23,8 +23,12 @@ THE SOFTWARE.
 package lib
 
 import (
+	"context"
+	"errors"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/go-helper/v2/str"
@@ -32,9 +36,27 @@ import (
 	"github.com/runZeroInc/go-rod"
 )
 
+// Wait for first video of page. Page without video after this is empty, eg. channel without live streams.
+const VideoWaitTimeout = 15 * time.Second
+
+// Selectors of short in subscription, channel and history page
+const (
+	shortsLockup = "ytm-shorts-lockup-view-model, ytd-reel-item-renderer"
+	shortsLink   = "a[href^='/shorts/']"
+	shortsTitle  = "h3, #video-title"
+)
+
 type IsSubVideo struct {
 	*is.Processor
-	Day uint
+	Day      uint
+	Exclude  *[]string    // filter terms, see [FilterMatch]
+	Include  *[]string    // filter terms, see [FilterMatch]
+	Resolver *ChResolver  // resolve channel if not nil
+	Shorts   string       // shorts policy, see [ShortsMatch]
+	Stat     *ElementStat // element extraction errors
+	Stream   *Stream      // write info as extracted if not nil
+
+	chDefault *YT_Info // channel of items without channel info, eg. channel page
 }
 
 func (t *IsSubVideo) New(page *rod.Page, urlStr string, scrollMax int, day uint) *IsSubVideo {
@@ -49,6 +71,7 @@ func (t *IsSubVideo) New(page *rod.Page, urlStr string, scrollMax int, day uint)
 	t.MyType = "IsSubVideo"
 	prefix := t.MyType + ".New"
 	t.Day = day
+	t.Stat = new(ElementStat)
 	t.override()
 
 	// ezlog.Trace().N(prefix).M("Done")
@@ -57,13 +80,14 @@ func (t *IsSubVideo) New(page *rod.Page, urlStr string, scrollMax int, day uint)
 }
 
 func (t *IsSubVideo) Run() *IsSubVideo {
-	t.Processor.Run()
+	ProcessorRun(t.Processor, t.Stat, t.Stream)
 	return t
 }
 
 func (t *IsSubVideo) override() {
 	t.V020_Elements = t.override_V020_Elements
-	t.V030_ElementInfo = t.override_V030_ElementInfo
+	t.V030_ElementInfo = func() { t.Stat.Guard(t.Processor, t.override_V030_ElementInfo) }
+	t.V040_ElementMatch = t.override_V040_ElementMatch
 	t.V100_ScrollLoopEnd = t.override_V100_ScrollLoopEnd
 }
 
@@ -71,8 +95,15 @@ func (t *IsSubVideo) override_V020_Elements() {
 	prefix := t.MyType + ".V020_Elements"
 	t.StateCurr.Name = prefix
 	tagName := "ytd-rich-item-renderer"
-	t.Page.MustElement(tagName)
-	t.StateCurr.Elements = t.Page.MustElements(tagName)
+	if _, t.Err = t.Page.Timeout(VideoWaitTimeout).Element(tagName); t.Err == nil {
+		t.StateCurr.Elements, t.Err = t.Page.Elements(tagName)
+	} else if errors.Is(t.Err, context.DeadlineExceeded) {
+		ezlog.Debug().N(prefix).M("no video").Out()
+		t.Err = nil
+	}
+	if t.Err != nil {
+		ezlog.Err().N(prefix).M(t.Err).Out()
+	}
 	ezlog.Debug().N(prefix).N("elements count").M(len(t.StateCurr.Elements)).Out()
 }
 
@@ -82,50 +113,111 @@ func (t *IsSubVideo) override_V030_ElementInfo() {
 	t.StateCurr.Name = prefix
 	if t.StateCurr.Element != nil {
 		var (
+			eTexts  rod.Elements
 			info    YT_Info
 			tagName string
 		)
-		// Tile block("h3"): title and link of the video
-		eH3 := t.StateCurr.Element.MustElement("h3")
-		info.Title = eH3.MustText()
-		info.Url = YT_FullUrl(*eH3.MustElement("a").MustAttribute("href"))
+		t.StateCurr.ElementInfo = &info
+		x := Extract{E: t.StateCurr.Element, Info: &info}
+		// Tile block("h3"): title and link of the video. Short has no meta block.
+		short, _, _ := t.StateCurr.Element.Has(shortsLockup)
+		if short {
+			info.Title = x.Text(shortsTitle)
+			info.Url = YT_FullUrl(x.Attr(shortsLink, "href"))
+		} else {
+			info.Title = x.Text("h3")
+			info.Url = YT_FullUrl(x.Attr("h3 a", "href"))
+		}
 		// Meta element: channel info, views and date
 		tagName = "yt-content-metadata-view-model"
 		eMeta, err := t.StateCurr.Element.Element(tagName)
 		if err == nil && eMeta != nil {
 			// Meta element -> link(<a>) block
-			a, e2 := eMeta.Element("a")
-			if e2 == nil {
-				info.ChTitle = a.MustText()
-				info.ChUrlShort = UrlDecode(*a.MustAttribute("href"))
+			if a, e2 := eMeta.Element("a"); e2 == nil {
+				xa := Extract{E: a, Info: &info}
+				info.ChTitle = xa.Text("")
+				info.ChUrlShort = UrlDecode(xa.Attr("", "href"))
 				info.ChUrl = YT_FullUrl(info.ChUrlShort)
-				// Meta element -> elements with [role]='text' attribute
-				tagName = "[role='text']"
-				eRoles, e3 := eMeta.Elements(tagName)
-				if e3 == nil {
-					excludeText := []string{"views", "watch", "scheduled"}
-					for _, eRole := range eRoles {
-						text := eRole.MustText()
-						if !str.ContainsAnySubStringsBool(text, &excludeText, false) {
-							info.Text = text
-							t.dayScroll(&text)
-						}
-						// search for watching, minutes, hours, day, <date>
-					}
-				}
+			}
+			// Meta element -> elements with [role]='text' attribute
+			eTexts, _ = eMeta.Elements("[role='text']")
+		} else {
+			// Grid layout meta line, eg. channel page
+			eMeta, err = t.StateCurr.Element.Element("#metadata-line")
+			if err == nil && eMeta != nil {
+				eTexts, _ = eMeta.Elements("span")
 			}
 		}
-		if err != nil {
-			// These are shorts with no meta block
-			info.Text = "Short"
-			// TraceElement(prefix, "", t.StateCurr.Element)
+		t.V031_ElementText(&info, eTexts)
+		t.V032_ElementType(&info, short)
+		if t.chDefault != nil && len(info.ChTitle) == 0 {
+			info.ChId = t.chDefault.ChId
+			info.ChTitle = t.chDefault.ChTitle
+			info.ChUrl = t.chDefault.ChUrl
+			info.ChUrlShort = t.chDefault.ChUrlShort
+		}
+		if t.Resolver != nil {
+			// channel of short is not on page
+			t.Resolver.ResolveVideo(t.Stat.Context(), &info)
 		}
 		// ---
 		ezlog.Debug().N(prefix).Lm(info).Out()
-		t.StateCurr.ElementInfo = &info
 	}
 }
 
+// Get views and date text from meta text elements
+func (t *IsSubVideo) V031_ElementText(info *YT_Info, eTexts rod.Elements) {
+	excludeText := []string{"views", "watch", "scheduled"}
+	for _, eText := range eTexts {
+		text, err := eText.Text()
+		if err != nil {
+			info.AddErr("text", err)
+			continue
+		}
+		if !str.ContainsAnySubStringsBool(text, &excludeText, false) {
+			info.Text = text
+			t.dayScroll(&text)
+		}
+		switch {
+		case str.ContainsAnySubStringsBool(text, &[]string{"watching"}, false):
+			info.Type = YT_TypeLive
+		case str.ContainsAnySubStringsBool(text, &[]string{"scheduled", "premieres", "waiting"}, false):
+			info.Type = YT_TypePremiere
+		}
+		// search for watching, minutes, hours, day, <date>
+	}
+}
+
+// Set type and members only of [info]. [short] is true if element is a shorts lockup.
+func (t *IsSubVideo) V032_ElementType(info *YT_Info, short bool) {
+	badge := "ytd-badge-supported-renderer, badge-shape"
+	if has, _, _ := t.StateCurr.Element.HasR(badge, "/members only/i"); has {
+		info.Members = true
+	}
+	if len(info.Type) == 0 {
+		if has, _, _ := t.StateCurr.Element.HasR(badge, "/^\\s*live\\s*$/i"); has {
+			info.Type = YT_TypeLive
+		}
+	}
+	switch {
+	case short || strings.Contains(info.Url, "/shorts/"):
+		info.Type = YT_TypeShort
+	case len(info.Type) == 0:
+		info.Type = YT_TypeVideo
+	}
+}
+
+func (t *IsSubVideo) override_V040_ElementMatch() {
+	prefix := t.MyType + ".V040_ElementMatch"
+	t.StateCurr.Name = prefix
+	info := t.StateCurr.ElementInfo.(*YT_Info)
+	matched, matchedStr := FilterMatch(info, t.Include, t.Exclude)
+	matched = matched && ShortsMatch(info, t.Shorts)
+	t.StateCurr.ElementInfo.SetMatched(matched)
+	t.StateCurr.ElementInfo.SetMatchedStr(matchedStr)
+	ezlog.Trace().N(prefix).N("matched").M(matched).N("matchedStr").M(matchedStr).Out()
+}
+
 func (t *IsSubVideo) override_V100_ScrollLoopEnd() {
 	prefix := t.MyType + ".V100_ScrollLoopEnd"
 	t.StateCurr.Name = prefix
@@ -216,6 +308,9 @@ func (t *IsSubVideo) dayScroll(text *string) {
 			}
 		}
 
+		if day > 0 {
+			t.Stat.Progress.Age(day, uint64(t.Day))
+		}
 		t.StateCurr.Scroll = true
 		if day > uint64(t.Day) {
 			t.StateCurr.Scroll = false
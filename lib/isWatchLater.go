@@ -0,0 +1,154 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"strconv"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/runZeroInc/go-rod"
+)
+
+// Process YT Watch Later, match watched videos
+type IsWatchLater struct {
+	IsPlaylistVideo
+
+	Del     bool            // delete matched video from watch later
+	Deleted bool            // In Run(), elements loop, current element is deleted or not
+	Percent int             // match video with watched percentage >= Percent
+	Watched map[string]bool // match video id in map, eg. from history
+
+	deleted  int          // deleted count in current scroll loop
+	lastKept *rod.Element // last element not deleted, for scrolling
+	menu     Menu3Dot
+}
+
+func (t *IsWatchLater) New(page *rod.Page, urlStr string, scrollMax int, del bool, percent int, watched map[string]bool) *IsWatchLater {
+	t.IsPlaylistVideo.New(page, urlStr, scrollMax) // Init the base struct
+	t.MyType = "IsWatchLater"
+
+	t.Del = del
+	t.Percent = percent
+	t.Watched = watched
+	t.override()
+
+	t.menu.New(page, "Remove from Watch later")
+	t.menu.Tags = []string{"#menu button", "button"}
+
+	return t
+}
+
+func (t *IsWatchLater) Run() *IsWatchLater {
+	ProcessorRun(t.Processor, t.Stat, t.Stream)
+	return t
+}
+
+func (t *IsWatchLater) override() {
+	t.V030_ElementInfo = func() { t.Stat.Guard(t.Processor, t.override_V030_ElementInfo) }
+	t.V040_ElementMatch = t.override_V040_ElementMatch
+	t.V050_ElementProcessMatched = t.override_V050_ElementProcessMatched
+	t.V090_ElementLoopEnd = t.override_V090_ElementLoopEnd
+	t.V100_ScrollLoopEnd = t.override_V100_ScrollLoopEnd
+}
+
+func (t *IsWatchLater) override_V030_ElementInfo() {
+	prefix := t.MyType + ".V030_ElementInfo"
+	t.IsPlaylistVideo.override_V030_ElementInfo()
+	t.StateCurr.Name = prefix
+	if t.StateCurr.ElementInfo != nil {
+		info := t.StateCurr.ElementInfo.(*YT_Info)
+		// progress bar width is the watched percentage
+		js := `() => {
+			const e = this.querySelector('ytd-thumbnail-overlay-resume-playback-renderer #progress, .ytThumbnailOverlayProgressBarHostWatchedProgressBarSegment')
+			return e ? (parseFloat(e.style.width) || 0) : 0
+		}`
+		obj, err := t.StateCurr.Element.Eval(js)
+		if err == nil {
+			info.Progress = obj.Value.Int()
+		} else {
+			info.AddErr("progress", err)
+		}
+		ezlog.Debug().N(prefix).N("Progress").M(info.Progress).N("Title").M(info.Title).Out()
+	}
+}
+
+func (t *IsWatchLater) override_V040_ElementMatch() {
+	prefix := t.MyType + ".V040_ElementMatch"
+	t.StateCurr.Name = prefix
+	var (
+		info       = t.StateCurr.ElementInfo.(*YT_Info)
+		matched    bool
+		matchedStr string
+	)
+	t.Deleted = false
+	if t.Percent > 0 && info.Progress >= t.Percent {
+		matched = true
+		matchedStr = "watched " + strconv.Itoa(info.Progress) + "%"
+	} else if t.Watched != nil && t.Watched[YT_VideoId(info.Url)] {
+		matched = true
+		matchedStr = "history"
+	}
+	t.StateCurr.ElementInfo.SetMatched(matched)
+	t.StateCurr.ElementInfo.SetMatchedStr(matchedStr)
+	ezlog.Trace().N(prefix).N("matched").M(matched).N("matchedStr").M(matchedStr).Out()
+}
+
+func (t *IsWatchLater) override_V050_ElementProcessMatched() {
+	prefix := t.MyType + ".V050_ElementProcessMatched"
+	t.StateCurr.Name = prefix
+	t.Deleted = t.menu.Delete(t.Processor, t.Stat, t.Del)
+	if t.Deleted {
+		t.deleted++
+	}
+}
+
+// Deleted element is removed from page, cannot be used for scrolling
+func (t *IsWatchLater) override_V090_ElementLoopEnd() {
+	prefix := t.MyType + ".V090_ElementLoopEnd"
+	t.StateCurr.Name = prefix
+	if !t.Deleted {
+		t.lastKept = t.StateCurr.Element
+	}
+	t.StateCurr.ScrollableElement = t.lastKept
+}
+
+// Deleted elements shift the remaining elements forward
+func (t *IsWatchLater) override_V100_ScrollLoopEnd() {
+	prefix := t.MyType + ".V100_ScrollLoopEnd"
+	t.StateCurr.Name = prefix
+	t.StateCurr.ElementsCount -= t.deleted
+	ezlog.Debug().N(prefix).N("deleted").M(t.deleted).N("ElementsCount").M(t.StateCurr.ElementsCount).Out()
+	t.deleted = 0
+}
+
+func (t *IsWatchLater) Print(verbose bool) *IsWatchLater {
+	mode := is.PrintMatched
+	if verbose {
+		mode = is.PrintAll
+	}
+	ezlog.Log().M("no|match|video|ch|desc").Out()
+	ezlog.Log().M("--|--|--|--|--").Out()
+	t.IInfoList.Print(mode)
+	return t
+}
@@ -0,0 +1,74 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+)
+
+var (
+	logDiag = ezlog.New().SetOutFunc(func(msg string) { fmt.Fprintln(os.Stderr, msg) }) // logger of [Diag]
+	logTime bool                                                                        // see [LogTime]
+)
+
+// Log message regardless of log level, same as ezlog.Log(). It is a diagnostic written to stderr, see [LogSetOut].
+func Diag() *ezlog.EzLog { return logDiag.Log() }
+
+// Set output of ezlog and [Diag]. Messages with log level, eg. ezlog.Err(), and [Diag] messages are written to [stderr].
+// ezlog.Log() messages, eg. md output, are written to [stdout].
+func LogSetOut(stdout, stderr io.Writer) {
+	ezlog.SetOutFunc(func(msg string) {
+		if logLeveled(msg) {
+			logLine(stderr, msg)
+		} else {
+			fmt.Fprintln(stdout, msg)
+		}
+	})
+	logDiag.SetOutFunc(func(msg string) { logLine(stderr, msg) })
+}
+
+// Prefix time to messages written to stderr. Use this instead of ezlog.EnableTime(), which hides log level from [LogSetOut].
+func LogTime(enable bool) { logTime = enable }
+
+func logLine(w io.Writer, msg string) {
+	if logTime {
+		msg = time.Now().Format(time.DateTime) + ": " + msg
+	}
+	fmt.Fprintln(w, msg)
+}
+
+// Return true if [msg] starts with log level prefix, eg. "ERR: "
+func logLeveled(msg string) bool {
+	for level := ezlog.EMERG; level <= ezlog.TRACE; level++ {
+		if strings.HasPrefix(msg, level.String()+": ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+)
+
+func TestLogSetOut(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	LogSetOut(&stdout, &stderr)
+	defer LogSetOut(&bytes.Buffer{}, &bytes.Buffer{})
+	for _, enable := range []bool{false, true} {
+		stdout.Reset()
+		stderr.Reset()
+		LogTime(enable)
+		ezlog.Log().M("output").Out()
+		ezlog.Err().M("boom").Out()
+		Diag().N("diag").M("note").Out()
+		if got := stdout.String(); got != "output\n" {
+			t.Errorf("time %v: stdout = %q", enable, got)
+		}
+		got := stderr.String()
+		if !strings.Contains(got, "ERR: boom\n") || !strings.Contains(got, "diag: note\n") || strings.Contains(got, "output") {
+			t.Errorf("time %v: stderr = %q", enable, got)
+		}
+		if lines := strings.Split(strings.TrimSpace(got), "\n"); enable && !strings.HasPrefix(lines[0], "20") {
+			t.Errorf("time %v: stderr without time %q", enable, got)
+		}
+	}
+	LogTime(false)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-helper/v2/state"
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/runZeroInc/go-rod"
+	"github.com/runZeroInc/go-rod/lib/input"
+	"github.com/runZeroInc/go-rod/lib/proto"
+)
+
+// Click an item in the 3-dot popup menu of an element
+type Menu3Dot struct {
+	basestruct.Base
+
+	Clicked  bool      // menu item clicked in last Run()
+	ItemText string    // menu item to click, case insensitive
+	Page     *rod.Page // page containing the element
+	Tags     []string  // selectors of the 3-dot button, tried in order
+
+	state state.State[V050_StateData]
+}
+
+type V050_StateData struct {
+	Element  *rod.Element // element being worked on by current state
+	Entry    *rod.Element // element owning the 3-dot button
+	SleepMax int64
+	SleepMin int64
+}
+
+func (t *Menu3Dot) New(page *rod.Page, itemText string) *Menu3Dot {
+	t.Initialized = true
+	t.MyType = "Menu3Dot"
+
+	t.ItemText = itemText
+	t.Page = page
+	t.Tags = []string{
+		"button",
+		".yt-lockup-metadata-view-model__menu-button"}
+
+	t.state = state.State[V050_StateData]{
+		Data: V050_StateData{
+			SleepMax: 300,
+			SleepMin: 100,
+		},
+		OnErr:         t.V051_OnErrFunc,
+		OnErrContinue: true,
+		Pre:           t.V051_FuncPre,
+	}
+	t.state.MyType = t.MyType + ".state"
+
+	return t
+}
+
+// Run the state machine on [entry]. State errors are joined in Err.
+func (t *Menu3Dot) Run(entry *rod.Element) *Menu3Dot {
+	t.Clicked = false
+	t.Err = nil
+	t.state.Data.Element = nil
+	t.state.Data.Entry = entry
+	t.state.Run(t.V0511_WaitStable)
+	return t
+}
+
+// Delete current element of [p] by menu item if [del]. Element with partial info or not visible is not deleted.
+// Overlays of [stat] are dismissed first. Menu error is added to info and element is flagged in [stat].
+// Return true if deleted.
+func (t *Menu3Dot) Delete(p *is.Processor, stat *ElementStat, del bool) bool {
+	// partial info is not deleted
+	info := p.StateCurr.ElementInfo.(*YT_Info)
+	if !del || len(info.Errs) > 0 || !Visible(p.StateCurr.Element) {
+		return false
+	}
+	// overlay blocks menu click
+	stat.Overlay.Run()
+	t.Run(p.StateCurr.Element)
+	stat.Progress.Delete(t.Clicked)
+	if !t.Clicked && t.Err != nil {
+		info.AddErr("delete", t.Err)
+		stat.Flag(p, info)
+	}
+	return t.Clicked
+}
+
+// sleep between min and max ms before each state
+func (t *Menu3Dot) V051_FuncPre() *state.State[V050_StateData] {
+	prefix := t.MyType + ".V051_FuncPre"
+	t.state.Name = prefix
+	var (
+		duration int64
+	)
+	duration = t.state.Data.SleepMin + rand.Int64N(t.state.Data.SleepMax-t.state.Data.SleepMin)
+	ezlog.Info().N(prefix).N("sleep(ms)").M(int64(duration)).Out()
+	time.Sleep(time.Millisecond * time.Duration(duration))
+	return &t.state
+}
+
+func (t *Menu3Dot) V051_OnErrFunc() *state.State[V050_StateData] {
+	t.Err = errors.Join(t.Err, errors.New(t.state.Name+": "+t.state.Err.Error()))
+	return &t.state
+}
+
+func (t *Menu3Dot) V0511_WaitStable() *state.State[V050_StateData] {
+	prefix := t.MyType + ".V0511"
+	t.state.Name = prefix
+	t.state.Err = t.Page.Keyboard.Press(input.Escape)
+	if t.state.Err == nil {
+		t.Page.Mouse.Scroll(0, 12, 3)
+		// WaitPageStable(prefix, t.Page)
+		t.state.Next = t.V0512_3DotClick
+	} else {
+		t.state.Next = t.V0511_WaitStable
+	}
+	return &t.state
+}
+
+// Click the 3-dot button to open popup menu
+func (t *Menu3Dot) V0512_3DotClick() *state.State[V050_StateData] {
+	prefix := t.MyType + ".V0512"
+	t.state.Name = prefix
+	// select 3-dot
+	for _, tag := range t.Tags {
+		t.state.Data.Element, t.state.Err = t.state.Data.Entry.Element(tag)
+		if t.state.Err == nil {
+			break
+		}
+	}
+	if t.state.Err != nil {
+		t.state.Next = nil
+	}
+	ezlog.Debug().N(prefix).N("t.state.Err").M(t.state.Err).Out()
+	if t.state.Err == nil {
+		// make 3-dot within screen
+		// t.state.Data.Element.MustVisible()
+		// click 3-dot
+		t.state.Err = t.state.Data.Element.Click(proto.InputMouseButtonLeft, 1)
+		ezlog.Debug().N(prefix).M("clicked").Out()
+	}
+	if t.state.Err == nil {
+		t.state.Next = t.V0513_MenuSelect
+	} else {
+		t.state.Next = t.V0511_WaitStable
+	}
+	return &t.state
+}
+
+// Select the popup menu
+func (t *Menu3Dot) V0513_MenuSelect() *state.State[V050_StateData] {
+	prefix := t.MyType + ".V0513"
+	t.state.Name = prefix
+	var (
+		elements rod.Elements
+		// tag      = "tp-yt-paper-listbox,tp-yt-iron-dropdown"
+		tag = "#contentWrapper"
+	)
+	// select menu
+	t.state.Data.Element = nil
+	elements, t.state.Err = t.Page.Elements(tag) // tag
+	if t.state.Err == nil {
+		for _, element := range elements {
+			if Visible(element) {
+				t.state.Data.Element = element
+				t.state.Next = t.V0514_MenuRead
+				break
+			}
+		}
+		if t.state.Data.Element == nil {
+			t.state.Err = errors.New("cannot select menu")
+		}
+	}
+	if t.state.Err != nil {
+		t.state.Next = t.V0511_WaitStable
+	}
+	return &t.state
+}
+
+// Read menu items
+func (t *Menu3Dot) V0514_MenuRead() *state.State[V050_StateData] {
+	prefix := t.MyType + ".V0514"
+	t.state.Name = prefix
+	// TraceElement(prefix, "", t.state.Element)
+	var (
+		matched      bool
+		menuItems    rod.Elements
+		menuItemText string
+		tag          = "ytd-menu-service-item-renderer,yt-list-item-view-model"
+	)
+	menuItems, t.state.Err = t.state.Data.Element.Elements(tag) // tag
+	if t.state.Err == nil {
+		if len(menuItems) > 0 {
+			for _, item := range menuItems {
+				menuItemText, _ = item.Text()
+				menuItemText = strings.TrimSpace(menuItemText)
+				ezlog.Trace().N(prefix).N("menuItems").M("'" + menuItemText + "'").Out()
+				if strings.EqualFold(menuItemText, t.ItemText) {
+					t.state.Data.Element = item
+					t.state.Next = t.V0515_MenuClick
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				TraceElement(ezlog.TRACE, prefix, "", t.state.Data.Element)
+				t.state.Err = errors.New("unmatch: " + t.ItemText)
+			}
+		} else {
+			t.state.Err = errors.New("0 menu item")
+		}
+	}
+	if t.state.Err != nil {
+		t.state.Next = t.V0511_WaitStable
+	}
+	return &t.state
+}
+
+// Click the item, use t.state.Element from V0514
+func (t *Menu3Dot) V0515_MenuClick() *state.State[V050_StateData] {
+	prefix := t.MyType + ".V0515"
+	t.state.Name = prefix
+	TraceElement(ezlog.TRACE, prefix, "", t.state.Data.Element)
+	var (
+		x, y  float64
+		box   *proto.DOMRect
+		shape *proto.DOMGetContentQuadsResult
+	)
+	{
+		// -- just click
+		// t.state.Err = t.state.Data.Element.Click(proto.InputMouseButtonLeft, 1)
+	}
+	{
+		// -- random position click
+		shape, t.state.Err = t.state.Data.Element.Shape()
+		if t.state.Err == nil {
+			if shape == nil {
+				t.state.Err = errors.New("nil shape")
+			} else {
+				box = shape.Box()
+				ezlog.Trace().N(prefix).N("box").M(box).Out()
+				if box == nil {
+					t.state.Err = errors.New("nil box")
+				} else {
+					x = box.X + 1 + rand.Float64()*(box.Width-2)
+					y = box.Y + 1 + rand.Float64()*(box.Height-2)
+					t.state.Err = t.Page.Mouse.MoveTo(proto.Point{X: x, Y: y})
+					if t.state.Err == nil {
+						t.state.Err = t.Page.Mouse.Click(proto.InputMouseButtonLeft, 1)
+					}
+					t.Clicked = t.state.Err == nil
+				}
+			}
+		}
+	}
+	if t.state.Err == nil {
+		t.state.Next = nil
+	} else {
+		// TraceElement(prefix, t.state.Err.Error(), t.state.Element)
+		t.state.Next = t.V0511_WaitStable
+	}
+	return &t.state
+}
@@ -0,0 +1,106 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+// NewPipe service id of YouTube
+const NewPipeServiceYT = 0
+
+// NewPipe subscriptions.json
+type NewPipeSubscriptions struct {
+	AppVersion    string                `json:"app_version"`
+	AppVersionInt int                   `json:"app_version_int"`
+	Subscriptions []NewPipeSubscription `json:"subscriptions"`
+}
+
+type NewPipeSubscription struct {
+	ServiceId int    `json:"service_id"`
+	Url       string `json:"url"`
+	Name      string `json:"name"`
+}
+
+// Export channels in [list] as NewPipe subscriptions.json. Channel id url is used if available.
+func NewPipeExport(list *is.IInfoList) ([]byte, error) {
+	subs := NewPipeSubscriptions{
+		AppVersion:    "0.27.6",
+		AppVersionInt: 1005,
+		Subscriptions: []NewPipeSubscription{},
+	}
+	for _, iinfo := range *list {
+		info := iinfo.(*YT_Info)
+		sub := NewPipeSubscription{
+			ServiceId: NewPipeServiceYT,
+			Url:       info.ChUrl,
+			Name:      info.ChTitle,
+		}
+		if len(info.ChId) > 0 {
+			sub.Url = YT_FullUrl("/channel/" + info.ChId)
+		}
+		if len(sub.Url) > 0 {
+			subs.Subscriptions = append(subs.Subscriptions, sub)
+		}
+	}
+	return json.MarshalIndent(&subs, "", "  ")
+}
+
+// Return true if [b] is a NewPipe subscriptions.json.
+// A single line FreeTube profiles.db is also a json object, but without "app_version".
+func NewPipeIs(b []byte) bool {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(b, &fields) != nil {
+		return false
+	}
+	return fields["app_version"] != nil && fields["subscriptions"] != nil
+}
+
+// Import YouTube channels from NewPipe subscriptions.json. Other services are skipped.
+func NewPipeImport(b []byte) (*is.IInfoList, error) {
+	var subs NewPipeSubscriptions
+	if err := json.Unmarshal(b, &subs); err != nil {
+		return nil, err
+	}
+	if subs.Subscriptions == nil {
+		return nil, errors.New("not a NewPipe subscriptions file")
+	}
+	list := new(is.IInfoList)
+	for _, sub := range subs.Subscriptions {
+		if sub.ServiceId != NewPipeServiceYT {
+			continue
+		}
+		info := YT_Info{
+			ChTitle: sub.Name,
+			ChUrl:   YT_ChannelUrl(sub.Url),
+		}
+		info.ChUrlShort = strings.TrimPrefix(info.ChUrl, YT_Base)
+		info.ChId = new(YT_Channel).SetPath(info.ChUrlShort).Id
+		*list = append(*list, &info)
+	}
+	return list, nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+)
+
+// Send video info to webhook, command and smtp sinks
+type Notifier struct {
+	basestruct.Base
+
+	Conf    *conf.TypeNotify
+	Timeout time.Duration // per sink
+}
+
+func (t *Notifier) New(notify *conf.TypeNotify) *Notifier {
+	t.Initialized = true
+	t.MyType = "Notifier"
+	t.Conf = notify
+	t.Timeout = 30 * time.Second
+	return t
+}
+
+// Return true if [info] channel is in Conf.Channels, or Conf.Channels is empty
+func (t *Notifier) Match(info *YT_Info) bool {
+	if len(t.Conf.Channels) == 0 {
+		return true
+	}
+	for _, ch := range t.Conf.Channels {
+		if ChMatch(info, ch) {
+			return true
+		}
+	}
+	return false
+}
+
+// Send [info] to all sinks. Sink errors are joined.
+func (t *Notifier) Send(info *YT_Info) error {
+	prefix := t.MyType + ".Send"
+	var errList []error
+	for _, webhook := range t.Conf.Webhooks {
+		errList = append(errList, t.webhook(&webhook, info))
+	}
+	for _, command := range t.Conf.Commands {
+		errList = append(errList, t.command(&command, info))
+	}
+	for _, s := range t.Conf.Smtp {
+		errList = append(errList, t.smtp(&s, info))
+	}
+	err := errors.Join(errList...)
+	ezlog.Debug().N(prefix).N(info.Url).M(err).Out()
+	return err
+}
+
+func (t *Notifier) webhook(webhook *conf.TypeNotifyWebhook, info *YT_Info) error {
+	body, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range webhook.Headers {
+		req.Header.Set(k, v)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode >= 300 {
+		return errors.New("webhook " + webhook.Url + ": " + res.Status)
+	}
+	return nil
+}
+
+func (t *Notifier) command(command *conf.TypeNotifyCommand, info *YT_Info) error {
+	if len(command.Args) == 0 {
+		return errors.New("command: empty Args")
+	}
+	var args []string
+	for _, arg := range command.Args {
+		args = append(args, InfoExpand(arg, info))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, args[0], args[1:]...).CombinedOutput()
+	if err != nil {
+		return errors.New("command " + args[0] + ": " + err.Error() + ": " + strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (t *Notifier) smtp(s *conf.TypeNotifySmtp, info *YT_Info) error {
+	var (
+		auth    smtp.Auth
+		body    = "{url}"
+		msg     strings.Builder
+		subject = "{channel}: {title}"
+	)
+	if len(s.To) == 0 {
+		return errors.New("smtp: empty To")
+	}
+	if len(s.Subject) > 0 {
+		subject = s.Subject
+	}
+	if len(s.Body) > 0 {
+		body = s.Body
+	}
+	if len(s.User) > 0 {
+		auth = smtp.PlainAuth("", s.User, s.Password, s.Host)
+	}
+	msg.WriteString("From: " + s.From + "\r\n")
+	msg.WriteString("To: " + strings.Join(s.To, ", ") + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", InfoExpand(subject, info)) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(InfoExpand(body, info) + "\r\n")
+	port := s.Port
+	if port == 0 {
+		port = 25
+	}
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(port))
+	return smtp.SendMail(addr, auth, s.From, s.To, []byte(msg.String()))
+}
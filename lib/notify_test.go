@@ -0,0 +1,157 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+)
+
+// Message received by smtpStandIn
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// Accept one SMTP session on a local port, without auth and TLS. Message is sent to the returned channel.
+func smtpStandIn(t *testing.T) (host string, port int, msgs chan smtpMessage) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	msgs = make(chan smtpMessage, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var (
+			msg smtpMessage
+			tp  = textproto.NewConn(conn)
+		)
+		tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				tp.PrintfLine("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				msg.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+				tp.PrintfLine("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				msg.to = append(msg.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+				tp.PrintfLine("250 OK")
+			case cmd == "DATA":
+				tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				msg.data = string(data)
+				tp.PrintfLine("250 OK")
+			case cmd == "QUIT":
+				tp.PrintfLine("221 Bye")
+				msgs <- msg
+				return
+			default:
+				tp.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, msgs
+}
+
+func TestNotifierSmtp(t *testing.T) {
+	info := &YT_Info{
+		ChTitle: "Go",
+		Title:   "Go 1.26 released",
+		Url:     "https://www.youtube.com/watch?v=abc123",
+	}
+	tests := []struct {
+		name    string
+		subject string
+		body    string
+		header  string
+		text    string
+	}{
+		{"default", "", "", "Subject: Go: Go 1.26 released", "https://www.youtube.com/watch?v=abc123"},
+		{"template", "New {title}", "{channel}\n{url}", "Subject: New Go 1.26 released", "Go\nhttps://www.youtube.com/watch?v=abc123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port, msgs := smtpStandIn(t)
+			notify := conf.TypeNotify{Smtp: []conf.TypeNotifySmtp{{
+				Host:    host,
+				Port:    port,
+				From:    "yt@example.com",
+				To:      []string{"a@example.com", "b@example.com"},
+				Subject: tt.subject,
+				Body:    tt.body,
+			}}}
+			if err := new(Notifier).New(&notify).Send(info); err != nil {
+				t.Fatal(err)
+			}
+			msg := <-msgs
+			// envelope
+			if msg.from != "yt@example.com" {
+				t.Errorf("from = %q", msg.from)
+			}
+			if strings.Join(msg.to, ",") != "a@example.com,b@example.com" {
+				t.Errorf("to = %q", msg.to)
+			}
+			// header and body
+			header, text, ok := strings.Cut(msg.data, "\n\n")
+			if !ok {
+				t.Fatalf("no header: %q", msg.data)
+			}
+			for _, line := range []string{"From: yt@example.com", "To: a@example.com, b@example.com", tt.header, "Content-Type: text/plain; charset=utf-8"} {
+				if !strings.Contains(header+"\n", line+"\n") {
+					t.Errorf("header %q not in %q", line, header)
+				}
+			}
+			if got := strings.TrimSuffix(text, "\n"); got != tt.text {
+				t.Errorf("body = %q, want %q", got, tt.text)
+			}
+		})
+	}
+}
+
+func TestNotifierSmtpNoTo(t *testing.T) {
+	notify := conf.TypeNotify{Smtp: []conf.TypeNotifySmtp{{Host: "127.0.0.1", From: "yt@example.com"}}}
+	if err := new(Notifier).New(&notify).Send(&YT_Info{}); err == nil {
+		t.Error("want error with empty To")
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+// Output formats of info list
+const (
+	OutputAtom   = "atom"
+	OutputJson   = "json"
+	OutputM3u    = "m3u"
+	OutputM3u8   = "m3u8" // same as m3u, always utf-8
+	OutputMd     = "md"
+	OutputNdjson = "ndjson" // one json per line, written by [Stream] as soon as extracted
+	OutputYtdlp  = "ytdlp"
+)
+
+// Return items of [list] selected by [mode]
+func ListFilter(list *is.IInfoList, mode is.IInfoListPrintMode) *is.IInfoList {
+	out := new(is.IInfoList)
+	for _, info := range *list {
+		if mode == is.PrintAll ||
+			mode == is.PrintMatched && info.Matched() ||
+			mode == is.PrintUnmatched && !info.Matched() {
+			*out = append(*out, info)
+		}
+	}
+	return out
+}
+
+// Set [YT_Info.Desc] of items in [list]
+func ListDesc(list *is.IInfoList, desc bool) *is.IInfoList {
+	for _, iinfo := range *list {
+		if info, ok := iinfo.(*YT_Info); ok {
+			info.Desc = desc
+		}
+	}
+	return list
+}
+
+// Print items of [list] selected by [mode] in [format].
+// [title] and [urlStr] are feed title and link of atom output.
+func ListPrint(list *is.IInfoList, mode is.IInfoListPrintMode, format, title, urlStr string) (err error) {
+	var b []byte
+	switch format {
+	case OutputMd, "":
+		list.Print(mode)
+		return nil
+	case OutputNdjson:
+		return new(Stream).New(os.Stdout, mode).Add(list).Err
+	case OutputJson:
+		b, err = json.MarshalIndent(ListFilter(list, mode), "", "  ")
+	case OutputM3u, OutputM3u8:
+		b = ListM3u(ListFilter(list, mode))
+	case OutputYtdlp:
+		b = ListYtdlp(ListFilter(list, mode), title)
+	case OutputAtom:
+		feed := new(AtomFeed).New(title, urlStr).Add(ListFilter(list, mode), time.Now())
+		b = feed.Bytes()
+		err = feed.Err
+	default:
+		err = errors.New("unknown output: " + format)
+	}
+	if err == nil {
+		ezlog.Log().M(string(b)).Out()
+	}
+	return err
+}
+
+// Extended M3U playlist of [list] items with url
+func ListM3u(list *is.IInfoList) []byte {
+	var buf strings.Builder
+	buf.WriteString("#EXTM3U")
+	for _, iinfo := range *list {
+		info, ok := iinfo.(*YT_Info)
+		if !ok || len(info.Url) == 0 {
+			continue
+		}
+		title := info.Title
+		if len(info.ChTitle) > 0 {
+			title = info.ChTitle + " - " + title
+		}
+		// title cannot span lines
+		title = strings.Join(strings.Fields(title), " ")
+		buf.WriteString("\n#EXTINF:-1," + title + "\n" + info.Url)
+	}
+	return []byte(buf.String())
+}
+
+// yt-dlp batch file (--batch-file) of [list] items with url. Title is added as comment.
+func ListYtdlp(list *is.IInfoList, title string) []byte {
+	var lines []string
+	if len(title) > 0 {
+		lines = append(lines, "# "+title)
+	}
+	for _, iinfo := range *list {
+		info, ok := iinfo.(*YT_Info)
+		if !ok || len(info.Url) == 0 {
+			continue
+		}
+		lines = append(lines, "# "+strings.Join(strings.Fields(info.Title), " "), info.Url)
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,163 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/yt-toolbox/v2/conf"
+	"github.com/runZeroInc/go-rod"
+	"github.com/runZeroInc/go-rod/lib/proto"
+)
+
+// Cookie consent setting of [conf.TypeOverlay]
+const (
+	OverlayConsentAccept = "accept"
+	OverlayConsentIgnore = "ignore"
+	OverlayConsentReject = "reject"
+)
+
+// Built-in overlays, can be disabled by name
+var OverlayBuiltin = []conf.TypeOverlayItem{
+	{Name: "still-watching", Selector: "yt-confirm-dialog-renderer", Text: "/continue watching|still watching/i", Button: "#confirm-button"},
+	{Name: "survey", Selector: "ytd-single-option-survey-renderer, ytd-inline-survey-renderer", Button: "#dismiss-button, #close-button, [aria-label=\"Dismiss\"]"},
+	{Name: "mealbar-promo", Selector: "ytd-mealbar-promo-renderer, yt-mealbar-promo-renderer", Button: "#dismiss-button"},
+	{Name: "popup-promo", Selector: "ytd-popup-container tp-yt-paper-dialog ytd-enforcement-message-view-model, ytd-popup-container tp-yt-paper-dialog yt-upsell-dialog-renderer", Button: "#dismiss-button, #close-button, [aria-label=\"Close\"]"},
+}
+
+// Button labels of consent dialog, in English, German, French, Spanish, Italian, Dutch, Polish, Portuguese, Swedish, Danish, Finnish, Czech
+const (
+	overlayRejectText = "/reject all|alle ablehnen|tout refuser|rechazar todo|rifiuta tutto|alles afwijzen|odrzuć wszystko|rejeitar tudo|avvisa alla|afvis alle|hylkää kaikki|odmítnout vše/i"
+	overlayAcceptText = "/accept all|alle akzeptieren|tout accepter|aceptar todo|accetta tutto|alles accepteren|zaakceptuj wszystko|aceitar tudo|godkänn alla|acceptér alle|hyväksy kaikki|přijmout vše/i"
+)
+
+// Cookie consent overlays by consent setting, consent page and consent dialog on YouTube.
+//
+// Consent page buttons are found by their form, "set_eom" is true for reject all, in any language.
+// Consent dialog buttons are found by label, see [overlayRejectText] and [overlayAcceptText].
+var OverlayConsent = map[string][]conf.TypeOverlayItem{
+	OverlayConsentReject: {
+		{Name: "consent-page", Url: "consent.", Selector: "body", Button: `form:has(input[name="set_eom"][value="true"]) button`},
+		{Name: "consent-dialog", Selector: "ytd-consent-bump-v2-lightbox", Button: "button", ButtonText: overlayRejectText},
+	},
+	OverlayConsentAccept: {
+		{Name: "consent-page", Url: "consent.", Selector: "body", Button: `form:has(input[name="set_eom"][value="false"]) button`},
+		{Name: "consent-dialog", Selector: "ytd-consent-bump-v2-lightbox", Button: "button", ButtonText: overlayAcceptText},
+	},
+	OverlayConsentIgnore: nil,
+}
+
+// Dismiss overlays blocking scrolling and clicking
+type Dismisser struct {
+	basestruct.Base
+
+	Count    int // overlays dismissed
+	Overlays []conf.TypeOverlayItem
+	Page     *rod.Page
+}
+
+// Setup overlays from [config]: consent overlays (reject if empty), built-in overlays not disabled, then custom overlays.
+// Built-in overlays with consent reject are used if [config] is nil.
+func (t *Dismisser) New(page *rod.Page, config *conf.TypeOverlay) *Dismisser {
+	t.Initialized = true
+	t.MyType = "Dismisser"
+	t.Page = page
+	if config == nil {
+		config = new(conf.TypeOverlay)
+	}
+	consent := strings.ToLower(strings.TrimSpace(config.Consent))
+	if len(consent) == 0 {
+		consent = OverlayConsentReject
+	}
+	overlays, ok := OverlayConsent[consent]
+	if !ok {
+		t.Err = errors.New(t.MyType + ": unknown Overlay.Consent: " + config.Consent + ", use reject, accept or ignore")
+	}
+	t.Overlays = slices.Clone(overlays)
+	for _, overlay := range OverlayBuiltin {
+		if !slices.ContainsFunc(config.Disable, func(name string) bool { return strings.EqualFold(name, overlay.Name) }) {
+			t.Overlays = append(t.Overlays, overlay)
+		}
+	}
+	t.Overlays = append(t.Overlays, config.Custom...)
+	return t
+}
+
+// Dismiss visible overlays on page. Each dismissal is logged.
+// Errors are logged in debug, not returned, as overlays are not required to be present.
+//
+// Return number of overlays dismissed. Nil safe.
+func (t *Dismisser) Run() (count int) {
+	if t == nil || t.Page == nil {
+		return 0
+	}
+	prefix := t.MyType + ".Run"
+	var urlStr string
+	if info, err := t.Page.Info(); err == nil {
+		urlStr = info.URL
+	}
+	for _, overlay := range t.Overlays {
+		if len(overlay.Url) > 0 && !strings.Contains(urlStr, overlay.Url) {
+			continue
+		}
+		ok, err := t.dismiss(&overlay)
+		if err != nil {
+			ezlog.Debug().N(prefix).N(overlay.Name).M(err).Out()
+		}
+		if ok {
+			count++
+			Diag().N("Overlay").N(overlay.Name).M("dismissed").Out()
+		}
+	}
+	t.Count += count
+	return count
+}
+
+// Click button of [overlay] if it is visible. Page is not waited.
+func (t *Dismisser) dismiss(overlay *conf.TypeOverlayItem) (ok bool, err error) {
+	var (
+		button, e *rod.Element
+		has       bool
+	)
+	if len(overlay.Text) > 0 {
+		has, e, err = t.Page.HasR(overlay.Selector, overlay.Text)
+	} else {
+		has, e, err = t.Page.Has(overlay.Selector)
+	}
+	if err != nil || !has || !Visible(e) {
+		return false, err
+	}
+	if len(overlay.ButtonText) > 0 {
+		button, err = e.ElementR(overlay.Button, overlay.ButtonText)
+	} else {
+		button, err = e.Element(overlay.Button)
+	}
+	if err == nil {
+		err = button.Click(proto.InputMouseButtonLeft, 1)
+	}
+	return err == nil, err
+}
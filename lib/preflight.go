@@ -0,0 +1,122 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/runZeroInc/go-rod"
+)
+
+// Page state found by [Preflight]
+var (
+	ErrCaptcha   = errors.New("captcha or unusual traffic page")
+	ErrConsent   = errors.New("consent page, accept or reject cookies in browser")
+	ErrSignedOut = errors.New("not signed in")
+)
+
+// Wait for YouTube page to show one of the known states
+const PreflightTimeout = 30 * time.Second
+
+// Selectors of page states, checked in order. Signed in page is checked first,
+// its content, eg. video title, cannot match other states.
+var preflightChecks = []struct {
+	err      error
+	selector string
+}{
+	{nil, accountAvatar},
+	{ErrCaptcha, "#captcha-form"},
+	{ErrCaptcha, `iframe[src*="recaptcha"]`},
+	{ErrConsent, "ytd-consent-bump-v2-lightbox"},
+	{ErrConsent, `form[action*="consent."]`},
+	{ErrSignedOut, `a[href*="accounts.google.com/ServiceLogin"]`},
+}
+
+// Check [page] is a signed in YouTube page, not consent or captcha page.
+// YouTube home is loaded if [page] is not YouTube.
+// Overlays, including cookie consent, are dismissed by [overlay] first if not nil.
+//
+// Return [ErrCaptcha], [ErrConsent] or [ErrSignedOut] wrapped, or other error if page state is unknown.
+func Preflight(page *rod.Page, overlay *Dismisser) (err error) {
+	prefix := "Preflight"
+	var urlStr string
+	page = page.Timeout(PreflightTimeout)
+	if urlStr, err = preflightUrl(page); err == nil && !strings.HasPrefix(urlStr, YT_Base) {
+		if err = page.Navigate(YT_Base); err == nil {
+			err = page.WaitLoad()
+		}
+		if err == nil {
+			urlStr, err = preflightUrl(page)
+		}
+	}
+	if err == nil && overlay.Run() > 0 {
+		// consent page redirects back to YouTube after dismissal
+		page.WaitLoad()
+		urlStr, err = preflightUrl(page)
+	}
+	if err == nil {
+		switch {
+		case strings.Contains(urlStr, "google.com/sorry"):
+			err = ErrCaptcha
+		case strings.Contains(urlStr, "consent.youtube.com"), strings.Contains(urlStr, "consent.google.com"):
+			err = ErrConsent
+		default:
+			err = preflightPage(page)
+		}
+	}
+	ezlog.Debug().N(prefix).N(urlStr).M(err).Out()
+	if err != nil {
+		err = fmt.Errorf("%s: %s: %w", prefix, urlStr, err)
+	}
+	return err
+}
+
+func preflightUrl(page *rod.Page) (string, error) {
+	info, err := page.Info()
+	if err != nil {
+		return "", err
+	}
+	return info.URL, nil
+}
+
+// Wait for any state, then check states in order
+func preflightPage(page *rod.Page) (err error) {
+	race := page.Race()
+	for _, check := range preflightChecks {
+		race.Element(check.selector)
+	}
+	if _, err = race.Do(); err != nil {
+		return errors.New("unknown page state: " + err.Error())
+	}
+	for _, check := range preflightChecks {
+		has, _, err := page.Has(check.selector)
+		if err != nil || has {
+			return errors.Join(err, check.err)
+		}
+	}
+	return nil
+}
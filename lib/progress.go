@@ -0,0 +1,214 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Minimum interval between redraw
+const ProgressInterval = 200 * time.Millisecond
+
+// Progress of processors in one line of a terminal, redrawn in place.
+//
+// All methods are safe to call on nil.
+type Progress struct {
+	W io.Writer // terminal
+
+	Processor string // current processor
+	Scroll    int    // scroll count of current processor
+	ScrollMax int    // scroll max of current processor, -1 unlimited
+	Found     int    // elements with info, all processors
+	Matched   int    // matched elements, all processors
+	Deleted   int    // deletion done
+	DelFailed int    // deletion failed
+	Oldest    uint64 // oldest age in day reached, subscription videos with --day
+	Day       uint64 // target of [Oldest]
+
+	mu       sync.Mutex
+	drawn    bool      // line is on screen
+	last     time.Time // last draw
+	start    time.Time // run start
+	procTime time.Time // current processor start
+	done     chan struct{}
+}
+
+// Return true if [f] is a terminal
+func IsTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Draw progress on [w], elapsed time is updated every second until [Close]
+func (t *Progress) New(w io.Writer) *Progress {
+	t.W = w
+	t.start = time.Now()
+	t.done = make(chan struct{})
+	done := t.done
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				t.Update(func() {})
+			}
+		}
+	}()
+	return t
+}
+
+// Stop update and clear the line
+func (t *Progress) Close() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done != nil {
+		close(t.done)
+		t.done = nil
+	}
+	t.clear()
+}
+
+// Start of processor [name]
+func (t *Progress) Start(name string, scrollMax int) {
+	t.Update(func() {
+		t.Processor = name
+		t.Scroll = 0
+		t.ScrollMax = scrollMax
+		t.procTime = time.Now()
+	})
+}
+
+// Result of one deletion
+func (t *Progress) Delete(ok bool) {
+	t.Update(func() {
+		if ok {
+			t.Deleted++
+		} else {
+			t.DelFailed++
+		}
+	})
+}
+
+// Age in [day] of a subscription video, scrolling until [target]
+func (t *Progress) Age(day, target uint64) {
+	t.Update(func() {
+		t.Day = target
+		t.Oldest = max(t.Oldest, day)
+	})
+}
+
+// Apply [f] and redraw if last draw is older than [ProgressInterval]
+func (t *Progress) Update(f func()) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	f()
+	if t.done != nil && time.Since(t.last) >= ProgressInterval {
+		t.draw()
+	}
+}
+
+// Writer clearing the progress line before each write to [w]. It is redrawn on next update.
+func (t *Progress) Writer(w io.Writer) io.Writer {
+	if t == nil {
+		return w
+	}
+	return &progressWriter{p: t, w: w}
+}
+
+type progressWriter struct {
+	p *Progress
+	w io.Writer
+}
+
+func (t *progressWriter) Write(b []byte) (int, error) {
+	t.p.mu.Lock()
+	defer t.p.mu.Unlock()
+	t.p.clear()
+	return t.w.Write(b)
+}
+
+// Progress line
+func (t *Progress) String() string {
+	now := time.Now()
+	scrollMax := "-"
+	if t.ScrollMax >= 0 {
+		scrollMax = strconv.Itoa(t.ScrollMax)
+	}
+	items := []string{
+		t.Processor,
+		"scroll " + strconv.Itoa(t.Scroll) + "/" + scrollMax,
+		"found " + strconv.Itoa(t.Found),
+		"matched " + strconv.Itoa(t.Matched),
+	}
+	if t.Deleted+t.DelFailed > 0 {
+		items = append(items, "deleted "+strconv.Itoa(t.Deleted)+" failed "+strconv.Itoa(t.DelFailed))
+	}
+	if t.Day > 0 {
+		items = append(items, "oldest "+strconv.FormatUint(t.Oldest, 10)+"/"+strconv.FormatUint(t.Day, 10)+"d")
+	}
+	items = append(items, now.Sub(t.start).Round(time.Second).String())
+	// ETA of current processor, from scroll or age progress
+	elapsed := now.Sub(t.procTime)
+	var eta time.Duration
+	if t.Day > 0 && t.Oldest > 0 && t.Oldest < t.Day {
+		eta = time.Duration(float64(elapsed) * float64(t.Day-t.Oldest) / float64(t.Oldest))
+	} else if t.ScrollMax > 0 && t.Scroll > 0 && t.Scroll < t.ScrollMax {
+		eta = elapsed / time.Duration(t.Scroll) * time.Duration(t.ScrollMax-t.Scroll)
+	}
+	if eta = eta.Round(time.Second); eta > 0 {
+		items = append(items, "eta "+eta.String())
+	}
+	return strings.Join(items, " | ")
+}
+
+func (t *Progress) draw() {
+	if len(t.Processor) == 0 {
+		return
+	}
+	fmt.Fprint(t.W, "\r\033[K"+t.String())
+	t.drawn = true
+	t.last = time.Now()
+}
+
+func (t *Progress) clear() {
+	if t.drawn {
+		fmt.Fprint(t.W, "\r\033[K")
+		t.drawn = false
+		t.last = time.Time{} // redraw on next update
+	}
+}
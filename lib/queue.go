@@ -0,0 +1,124 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+)
+
+type queueItem struct {
+	done chan error
+	f    func() error
+}
+
+// Returned by [Queue.Do] after [Queue.Close]
+var ErrQueueClosed = errors.New("queue closed")
+
+// Run functions one at a time, in order of submission
+type Queue struct {
+	basestruct.Base
+
+	ch     chan *queueItem
+	closed bool
+	done   chan struct{} // closed when worker returns
+	mu     sync.RWMutex  // guard closed and send on ch
+}
+
+func (t *Queue) New(size int) *Queue {
+	t.Initialized = true
+	t.MyType = "Queue"
+	t.ch = make(chan *queueItem, size)
+	t.done = make(chan struct{})
+	go t.worker()
+	return t
+}
+
+// Stop accepting new function, and wait for queued functions to return
+func (t *Queue) Close() {
+	t.mu.Lock()
+	if !t.closed {
+		t.closed = true
+		close(t.ch)
+	}
+	t.mu.Unlock()
+	<-t.done
+}
+
+// Queue [f] and wait for it to return. Panic in [f] is returned as error.
+//
+// If [ctx] is done before [f] returns, ctx.Err() is returned and [f] continues in background.
+// [ErrQueueClosed] is returned after [Queue.Close].
+func (t *Queue) Do(ctx context.Context, f func() error) error {
+	item := &queueItem{
+		done: make(chan error, 1),
+		f:    f,
+	}
+	if err := t.send(ctx, item); err != nil {
+		return err
+	}
+	select {
+	case err := <-item.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *Queue) send(ctx context.Context, item *queueItem) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.closed {
+		return ErrQueueClosed
+	}
+	select {
+	case t.ch <- item:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *Queue) worker() {
+	defer close(t.done)
+	for item := range t.ch {
+		item.done <- t.run(item.f)
+	}
+}
+
+func (t *Queue) run(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = errors.New(fmt.Sprint(r))
+			}
+		}
+	}()
+	return f()
+}
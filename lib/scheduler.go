@@ -0,0 +1,110 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+)
+
+// A scheduled job
+type SchedJob struct {
+	Name string
+	Cron Cron
+	Key  string                          // jobs with same key are serialized, eg. devtools host:port
+	Run  func(ctx context.Context) error // job function
+}
+
+// Run jobs on cron schedule until context is done
+type Scheduler struct {
+	basestruct.Base
+
+	Jobs []*SchedJob
+
+	locks map[string]*sync.Mutex
+	wg    sync.WaitGroup
+}
+
+func (t *Scheduler) New(jobs []*SchedJob) *Scheduler {
+	t.Initialized = true
+	t.MyType = "Scheduler"
+	t.Jobs = jobs
+	t.locks = make(map[string]*sync.Mutex)
+	for _, job := range jobs {
+		if t.locks[job.Key] == nil {
+			t.locks[job.Key] = new(sync.Mutex)
+		}
+	}
+	return t
+}
+
+// Block until [ctx] is done and all running jobs returned
+func (t *Scheduler) Run(ctx context.Context) *Scheduler {
+	prefix := t.MyType + ".Run"
+	Diag().N(prefix).N("jobs").M(len(t.Jobs)).Out()
+	for _, job := range t.Jobs {
+		t.wg.Add(1)
+		go t.loop(ctx, job)
+	}
+	t.wg.Wait()
+	Diag().N(prefix).M("stopped").Out()
+	return t
+}
+
+// Run [job] on schedule. A run missed while the job is running is skipped.
+func (t *Scheduler) loop(ctx context.Context, job *SchedJob) {
+	prefix := t.MyType + ".loop"
+	defer t.wg.Done()
+	lock := t.locks[job.Key]
+	for {
+		next := job.Cron.Next(time.Now())
+		if next.IsZero() {
+			ezlog.Err().N(prefix).N(job.Name).M("no next schedule").Out()
+			return
+		}
+		Diag().N(prefix).N(job.Name).N("next").M(next.Format(time.RFC3339)).Out()
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		lock.Lock()
+		if ctx.Err() == nil {
+			start := time.Now()
+			Diag().N(prefix).N(job.Name).TxtStart().Out()
+			err := job.Run(ctx)
+			if err == nil {
+				Diag().N(prefix).N(job.Name).N("duration").M(time.Since(start).Round(time.Second).String()).TxtEnd().Out()
+			} else {
+				ezlog.Err().N(prefix).N(job.Name).N("duration").M(time.Since(start).Round(time.Second).String()).N("err").M(err).Out()
+			}
+		}
+		lock.Unlock()
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-helper/v2/file"
+)
+
+// Seen file name in state directory
+const FileSeen = "seen.json"
+
+// Keep seen entries for
+const SeenMaxAge = 90 * 24 * time.Hour
+
+// Videos already seen, persisted in a file. Key is video id, or url if no id.
+type Seen struct {
+	basestruct.Base
+
+	File string               // no persistence if empty
+	Keys map[string]time.Time // key -> first seen time
+}
+
+func (t *Seen) New(fileSeen string) *Seen {
+	t.Initialized = true
+	t.MyType = "Seen"
+	prefix := t.MyType + ".New"
+	t.File = fileSeen
+	t.Keys = make(map[string]time.Time)
+	if len(t.File) > 0 && file.IsRegularFile(t.File) {
+		var b *[]byte
+		b, t.Err = file.ReadByte(t.File)
+		if t.Err == nil {
+			t.Err = json.Unmarshal(*b, &t.Keys)
+		}
+		if t.Err != nil {
+			ezlog.Err().N(prefix).M(t.Err).Out()
+		}
+	}
+	return t
+}
+
+// Mark [info] as seen. Return true if not seen before.
+func (t *Seen) Add(info *YT_Info) (added bool) {
+	key := YT_VideoId(info.Url)
+	if len(key) == 0 {
+		key = info.Url
+	}
+	if _, ok := t.Keys[key]; !ok {
+		t.Keys[key] = time.Now()
+		added = true
+	}
+	return added
+}
+
+func (t *Seen) Len() int { return len(t.Keys) }
+
+// Remove entries older than [SeenMaxAge], then write file
+func (t *Seen) Save() *Seen {
+	prefix := t.MyType + ".Save"
+	if len(t.File) > 0 {
+		for key, seen := range t.Keys {
+			if time.Since(seen) > SeenMaxAge {
+				delete(t.Keys, key)
+			}
+		}
+		var b []byte
+		b, t.Err = json.MarshalIndent(t.Keys, "", "  ")
+		if t.Err == nil {
+			t.Err = os.MkdirAll(filepath.Dir(t.File), 0755)
+		}
+		if t.Err == nil {
+			t.Err = file.WriteByte(t.File, &b, 0644)
+		}
+		if t.Err != nil {
+			ezlog.Err().N(prefix).M(t.Err).Out()
+		}
+	}
+	return t
+}
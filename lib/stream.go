@@ -0,0 +1,93 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/J-Siu/go-helper/v2/basestruct"
+	"github.com/J-Siu/go-helper/v2/ezlog"
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+// Write info as one JSON line (NDJSON) as soon as it is extracted.
+// Info with same url is written once, across scroll and processors.
+type Stream struct {
+	basestruct.Base
+
+	Count int                   // lines written
+	Mode  is.IInfoListPrintMode // info written, by matched status
+	W     io.Writer
+
+	seen map[string]bool
+}
+
+func (t *Stream) New(w io.Writer, mode is.IInfoListPrintMode) *Stream {
+	t.Initialized = true
+	t.MyType = "Stream"
+	t.Mode = mode
+	t.W = w
+	t.seen = make(map[string]bool)
+	return t
+}
+
+// Write [iinfo] if selected by [Mode] and not written before
+func (t *Stream) Emit(iinfo is.IInfo) *Stream {
+	prefix := t.MyType + ".Emit"
+	info, ok := iinfo.(*YT_Info)
+	if !ok || info == nil || t.Err != nil {
+		return t
+	}
+	if t.Mode == is.PrintMatched && !info.Matched() ||
+		t.Mode == is.PrintUnmatched && info.Matched() {
+		return t
+	}
+	key := info.Url
+	if len(key) == 0 {
+		key = info.String()
+	}
+	if t.seen[key] {
+		return t
+	}
+	t.seen[key] = true
+	var b []byte
+	b, t.Err = json.Marshal(info)
+	if t.Err == nil {
+		_, t.Err = t.W.Write(append(b, '\n'))
+	}
+	if t.Err != nil {
+		ezlog.Err().N(prefix).M(t.Err).Out()
+		return t
+	}
+	t.Count++
+	return t
+}
+
+// Write items of [list] not written before
+func (t *Stream) Add(list *is.IInfoList) *Stream {
+	for _, iinfo := range *list {
+		t.Emit(iinfo)
+	}
+	return t
+}
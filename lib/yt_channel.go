@@ -0,0 +1,82 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package lib
+
+import (
+	"strings"
+)
+
+// Channel identity
+type YT_Channel struct {
+	Handle string   `json:"Handle,omitempty"` // "@handle"
+	Id     string   `json:"Id,omitempty"`     // "UC..."
+	Paths  []string `json:"Paths,omitempty"`  // custom url path, eg. "/c/name", "/user/name"
+	Title  string   `json:"Title,omitempty"`  // display title, not unique
+}
+
+// Set handle, id or custom path from a channel url path
+func (t *YT_Channel) SetPath(path string) *YT_Channel {
+	path = "/" + strings.Trim(path, "/")
+	switch {
+	case path == "/":
+	case strings.HasPrefix(path, "/@"):
+		t.Handle = path[1:]
+	case strings.HasPrefix(path, "/channel/"):
+		t.Id = strings.TrimPrefix(path, "/channel/")
+	default:
+		for _, p := range t.Paths {
+			if strings.EqualFold(p, path) {
+				return t
+			}
+		}
+		t.Paths = append(t.Paths, path)
+	}
+	return t
+}
+
+// Return "/@handle" if available, else "/channel/<id>"
+func (t *YT_Channel) UrlShort() (urlShort string) {
+	if len(t.Handle) > 0 {
+		urlShort = "/" + t.Handle
+	} else if len(t.Id) > 0 {
+		urlShort = "/channel/" + t.Id
+	}
+	return
+}
+
+// Merge non-empty fields of [ch] into [t]
+func (t *YT_Channel) Merge(ch *YT_Channel) *YT_Channel {
+	if len(ch.Handle) > 0 {
+		t.Handle = ch.Handle
+	}
+	if len(ch.Id) > 0 {
+		t.Id = ch.Id
+	}
+	if len(ch.Title) > 0 {
+		t.Title = ch.Title
+	}
+	for _, p := range ch.Paths {
+		t.SetPath(p)
+	}
+	return t
+}
@@ -3,8 +3,6 @@ package lib
 import (
 	"errors"
 	"strings"
-
-	"github.com/J-Siu/go-helper/v2/errs"
 )
 
 // var 'ytinitialdata' from channel page
@@ -23,8 +21,13 @@ type YTInitialData struct {
 												ExpandedShelfContentsRenderer struct {
 													Items []struct {
 														ChannelRenderer struct {
-															ChannelID string `json:"channelId"`
-															Title     struct {
+															ChannelID          string `json:"channelId"`
+															NavigationEndpoint struct {
+																BrowseEndpoint struct {
+																	CanonicalBaseUrl string `json:"canonicalBaseUrl"`
+																} `json:"browseEndpoint"`
+															} `json:"navigationEndpoint"`
+															Title struct {
 																SimpleText string `json:"simpleText"`
 															} `json:"title"`
 														} `json:"channelRenderer"`
@@ -43,25 +46,38 @@ type YTInitialData struct {
 	} `json:"contents"`
 }
 
-// map (key,value) => (title,id)
-type ChTitleID map[string]string
+// ytInitialData.metadata.channelMetadataRenderer of channel page
+type YTChannelMetadata struct {
+	ChannelUrl       string `json:"channelUrl"`
+	ExternalId       string `json:"externalId"`
+	Title            string `json:"title"`
+	VanityChannelUrl string `json:"vanityChannelUrl"`
+}
 
-func (t ChTitleID) New(data *YTInitialData) {
-	prefix := "ChTitleID.New"
-	var title string
-	for _, tab := range data.Contents.TwoColumnBrowseResultsRenderer.Tabs {
+// Return channels in subscription channel page. Channels without title are returned with error.
+func (t *YTInitialData) Channels() (channels []YT_Channel, err error) {
+	var (
+		ch      YT_Channel
+		errList []error
+	)
+	for _, tab := range t.Contents.TwoColumnBrowseResultsRenderer.Tabs {
 		for _, content1 := range tab.TabRenderer.Content.SectionListRenderer.Contents {
 			for _, content2 := range content1.ItemSectionRenderer.Contents {
 				for _, item := range content2.ShelfRenderer.Content.ExpandedShelfContentsRenderer.Items {
-					title = strings.TrimSpace(item.ChannelRenderer.Title.SimpleText)
-					if title == "" {
-						errs.Queue(prefix, errors.New("Empty channel title: "+item.ChannelRenderer.ChannelID))
+					ch = YT_Channel{
+						Id:    strings.TrimSpace(item.ChannelRenderer.ChannelID),
+						Title: strings.TrimSpace(item.ChannelRenderer.Title.SimpleText),
+					}
+					ch.SetPath(UrlDecode(item.ChannelRenderer.NavigationEndpoint.BrowseEndpoint.CanonicalBaseUrl))
+					if ch.Title == "" {
+						errList = append(errList, errors.New("empty channel title: "+ch.Id))
 					}
-					t[title] = strings.TrimSpace(item.ChannelRenderer.ChannelID)
+					channels = append(channels, ch)
 				}
 			}
 		}
 	}
+	return channels, errors.Join(errList...)
 }
 
 // cspell:words ytinitialdata
@@ -23,8 +23,20 @@ THE SOFTWARE.
 package lib
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/J-Siu/go-is/v3/is"
-	"github.com/J-Siu/yt-toolbox/v2/global"
+)
+
+// Values of [YT_Info.Type]
+const (
+	YT_TypeLive     = "live"
+	YT_TypePremiere = "premiere"
+	YT_TypeShort    = "short"
+	YT_TypeVideo    = "video"
+
+	YT_TypeMembers = "members" // filter only, see [YT_Info.Members]
 )
 
 // Embed [is.InfoBase] for [is.IInfo] interface
@@ -37,16 +49,46 @@ type YT_Info struct {
 	ChUrl      string `json:"ChUrl,omitempty"`
 	ChUrlShort string `json:"ChUrlShort,omitempty"`
 	// --- Video info
-	Text   string   `json:"Text,omitempty"`
-	Title  string   `json:"Title,omitempty"`
-	Titles []string `json:"Titles,omitempty"`
-	Url    string   `json:"Url,omitempty"`
+	Desc     bool     `json:"-"`                  // include Text in [YT_Info.String]
+	Members  bool     `json:"Members,omitempty"`  // members only
+	Progress int      `json:"Progress,omitempty"` // watched percentage
+	Section  string   `json:"Section,omitempty"`  // history section title, eg. "Today"
+	Text     string   `json:"Text,omitempty"`
+	Title    string   `json:"Title,omitempty"`
+	Titles   []string `json:"Titles,omitempty"`
+	Type     string   `json:"Type,omitempty"` // video type, eg. [YT_TypeShort]
+	Url      string   `json:"Url,omitempty"`
+	// --- Extraction errors, info is partial if not empty
+	Errs []string `json:"Errs,omitempty"`
 }
 
 func (t *YT_Info) String() string {
 	str := "[" + t.Title + "](" + UrlDecode(t.Url) + ") | [" + t.ChTitle + "](" + t.ChUrl + ") | " + t.ChId
-	if global.Flag.Desc {
+	if t.Desc {
 		str += " | " + t.Text
 	}
+	if len(t.Errs) > 0 {
+		str += " | ERR: " + strings.Join(t.Errs, "; ")
+	}
 	return str
 }
+
+// Add extraction error of [field], ignored if [err] is nil
+func (t *YT_Info) AddErr(field string, err error) {
+	if err != nil {
+		if len(field) > 0 {
+			field += ": "
+		}
+		t.Errs = append(t.Errs, field+err.Error())
+	}
+}
+
+// Include matched status of [is.InfoBase]
+func (t *YT_Info) MarshalJSON() ([]byte, error) {
+	type info YT_Info
+	return json.Marshal(struct {
+		*info
+		Matched    bool   `json:"Matched"`
+		MatchedStr string `json:"MatchedStr,omitempty"`
+	}{(*info)(t), t.Matched(), t.MatchedStr()})
+}
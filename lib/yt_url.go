@@ -25,7 +25,17 @@ package lib
 const (
 	YT_Base        = "https://www.youtube.com"
 	YT_History     = "https://www.youtube.com/feed/history"
+	YT_Playlist    = "https://www.youtube.com/playlist?list="
 	YT_Playlists   = "https://www.youtube.com/feed/playlists"
 	YT_SubChannels = "https://www.youtube.com/feed/channels"
 	YT_SubVideos   = "https://www.youtube.com/feed/subscriptions"
+	YT_WatchLater  = "https://www.youtube.com/playlist?list=WL"
+)
+
+// Channel tab, append to channel url
+const (
+	YT_ChTabLive      = "/streams"
+	YT_ChTabPlaylists = "/playlists"
+	YT_ChTabShorts    = "/shorts"
+	YT_ChTabVideos    = "/videos"
 )
@@ -0,0 +1,248 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package yttoolbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+	"github.com/runZeroInc/go-rod"
+)
+
+var (
+	ErrClosed  = errors.New("client closed")
+	ErrConnect = errors.New("cannot connect to browser")
+
+	// Page state found by [Client.Preflight]
+	ErrCaptcha   = lib.ErrCaptcha
+	ErrConsent   = lib.ErrConsent
+	ErrSignedOut = lib.ErrSignedOut
+)
+
+// Error of a [Client] operation
+type Error struct {
+	Op  string // method name, eg. "SubscriptionChannels"
+	Err error
+}
+
+func (e *Error) Error() string { return "yttoolbox: " + e.Op + ": " + e.Err.Error() }
+func (e *Error) Unwrap() error { return e.Err }
+
+// Client is safe for concurrent use. Operations are run one at a time.
+type Client struct {
+	cacheFile string
+	closed    bool
+	host      string
+	page      *rod.Page
+	port      int
+	remote    bool
+	resolver  lib.ChResolver
+	scrollMax int
+	sem       chan struct{}
+}
+
+func New(opts ...Option) *Client {
+	c := &Client{
+		host: "localhost",
+		port: 9222,
+		sem:  make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.resolver.New(c.cacheFile, c.remote)
+	return c
+}
+
+// Check tab is a signed in YouTube page, not consent or captcha page.
+// Error wraps [ErrSignedOut], [ErrConsent] or [ErrCaptcha] for known page state.
+func (c *Client) Preflight(ctx context.Context) error {
+	return c.run(ctx, "Preflight", func(page *rod.Page) error {
+		return lib.Preflight(page, new(lib.Dismisser).New(page, nil))
+	})
+}
+
+// Save channel cache. Browser and tab are left open.
+func (c *Client) Close() error {
+	c.sem <- struct{}{}
+	defer func() { <-c.sem }()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	if err := c.resolver.Save().Err; err != nil {
+		return &Error{Op: "Close", Err: err}
+	}
+	return nil
+}
+
+// Subscribed channels, sorted by title
+func (c *Client) SubscriptionChannels(ctx context.Context) (channels []Channel, err error) {
+	err = c.run(ctx, "SubscriptionChannels", func(page *rod.Page) error {
+		isSubCh := new(lib.IsSubChannel).New(page, lib.YT_SubChannels, c.scrollMax)
+		isSubCh.Resolver = &c.resolver
+		isSubCh.Run()
+		if isSubCh.Err != nil {
+			return isSubCh.Err
+		}
+		sort.Sort(isSubCh.IInfoList)
+		channels = mapList(isSubCh.IInfoList, toChannel)
+		return nil
+	})
+	return channels, err
+}
+
+// Videos of subscription feed. If [day] > 0, scroll until videos older than [day] days.
+func (c *Client) SubscriptionVideos(ctx context.Context, day uint) (videos []Video, err error) {
+	err = c.run(ctx, "SubscriptionVideos", func(page *rod.Page) error {
+		isSubVideo := new(lib.IsSubVideo).New(page, lib.YT_SubVideos, c.scrollMax, day)
+		isSubVideo.Resolver = &c.resolver
+		isSubVideo.Run()
+		videos = mapList(isSubVideo.IInfoList, toVideo)
+		return isSubVideo.Err
+	})
+	return videos, err
+}
+
+// Playlists of the account
+func (c *Client) Playlists(ctx context.Context) (playlists []Playlist, err error) {
+	err = c.run(ctx, "Playlists", func(page *rod.Page) error {
+		var exclude, include []string
+		isPlaylist := new(lib.IsPlaylist).New(page, lib.YT_Playlists, c.scrollMax, &exclude, &include).Run()
+		if isPlaylist.Err != nil {
+			return isPlaylist.Err
+		}
+		sort.Sort(isPlaylist.IInfoList)
+		playlists = mapList(isPlaylist.IInfoList, toPlaylist)
+		return nil
+	})
+	return playlists, err
+}
+
+// Videos of playlist [playlist], a playlist url or id
+func (c *Client) PlaylistVideos(ctx context.Context, playlist string) (videos []Video, err error) {
+	urlStr := playlist
+	if !strings.HasPrefix(playlist, "http://") && !strings.HasPrefix(playlist, "https://") {
+		urlStr = lib.YT_Playlist + playlist
+	}
+	return c.playlistVideos(ctx, "PlaylistVideos", urlStr)
+}
+
+// Videos of Watch later
+func (c *Client) WatchLater(ctx context.Context) (videos []Video, err error) {
+	return c.playlistVideos(ctx, "WatchLater", lib.YT_WatchLater)
+}
+
+// Videos, shorts or live streams of channel [ch], a @handle, channel id or url
+func (c *Client) ChannelVideos(ctx context.Context, ch string, tab Tab) (videos []Video, err error) {
+	err = c.run(ctx, "ChannelVideos", func(page *rod.Page) error {
+		isChannelVideo := new(lib.IsChannelVideo).New(page, lib.YT_ChannelUrl(ch)+string(tab), c.scrollMax, 0)
+		isChannelVideo.Resolver = &c.resolver
+		isChannelVideo.Run()
+		videos = mapList(isChannelVideo.IInfoList, toVideo)
+		return isChannelVideo.Err
+	})
+	return videos, err
+}
+
+// Watch history, with section title, eg. "Today"
+func (c *Client) History(ctx context.Context) (videos []Video, err error) {
+	err = c.run(ctx, "History", func(page *rod.Page) error {
+		entries := new(is.IInfoList)
+		isHistorySection := new(lib.IsHistorySection).New(page, lib.YT_History, true, c.scrollMax, false)
+		isHistorySection.Entries = entries
+		isHistorySection.NoPrint = true
+		isHistorySection.PrintHeader = false
+		isHistorySection.Resolver = &c.resolver
+		isHistorySection.Run()
+		videos = mapList(entries, toVideo)
+		return isHistorySection.Err
+	})
+	return videos, err
+}
+
+func (c *Client) playlistVideos(ctx context.Context, op, urlStr string) (videos []Video, err error) {
+	err = c.run(ctx, op, func(page *rod.Page) error {
+		isVideoList := new(lib.IsPlaylistVideo).New(page, urlStr, c.scrollMax)
+		isVideoList.Resolver = &c.resolver
+		isVideoList.Run()
+		videos = mapList(isVideoList.IInfoList, toVideo)
+		return isVideoList.Err
+	})
+	return videos, err
+}
+
+// Run [f] with tab bound to [ctx]. Panic in [f] is returned as error.
+func (c *Client) run(ctx context.Context, op string, f func(page *rod.Page) error) (err error) {
+	defer func() {
+		if err != nil {
+			err = &Error{Op: op, Err: err}
+		}
+	}()
+	select {
+	case c.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-c.sem }()
+	if c.closed {
+		return ErrClosed
+	}
+	if c.page == nil {
+		if c.page, err = lib.GetTab(c.host, c.port); err != nil {
+			return fmt.Errorf("%w: %w", ErrConnect, err)
+		}
+	}
+	page := c.page.Context(ctx)
+	c.resolver.Page = page
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = errors.New(fmt.Sprint(r))
+			}
+		}
+		// rod error on cancel is not ctx error
+		if err != nil && ctx.Err() != nil {
+			err = ctx.Err()
+		}
+	}()
+	return f(page)
+}
+
+// Map [YT_Info] in [list] with [f]
+func mapList[T any](list *is.IInfoList, f func(*lib.YT_Info) T) []T {
+	out := []T{}
+	if list != nil {
+		for _, iinfo := range *list {
+			out = append(out, f(iinfo.(*lib.YT_Info)))
+		}
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package yttoolbox
+
+import (
+	"github.com/runZeroInc/go-rod"
+)
+
+// Option of [New]
+type Option func(*Client)
+
+// Browser devtools host and port. Default "localhost", 9222.
+func WithDevtools(host string, port int) Option {
+	return func(c *Client) {
+		c.host = host
+		c.port = port
+	}
+}
+
+// Use [page] instead of connecting to devtools
+func WithPage(page *rod.Page) Option {
+	return func(c *Client) { c.page = page }
+}
+
+// Number of page scrolls. 0 = first page only, -1 = unlimited. Default 0.
+func WithScrollMax(scrollMax int) Option {
+	return func(c *Client) { c.scrollMax = scrollMax }
+}
+
+// Channel cache file, saved by [Client.Close]. Default in memory only.
+func WithChannelCache(file string) Option {
+	return func(c *Client) { c.cacheFile = file }
+}
+
+// Look up channel not in cache by opening channel page, and channel of shorts by oEmbed. Default cache only.
+func WithRemoteResolve() Option {
+	return func(c *Client) { c.remote = true }
+}
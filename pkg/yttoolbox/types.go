@@ -0,0 +1,105 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package yttoolbox
+
+import (
+	"strings"
+
+	"github.com/J-Siu/yt-toolbox/v2/lib"
+)
+
+// Channel tab for [Client.ChannelVideos]
+type Tab string
+
+const (
+	TabLive   Tab = lib.YT_ChTabLive
+	TabShorts Tab = lib.YT_ChTabShorts
+	TabVideos Tab = lib.YT_ChTabVideos
+)
+
+type Channel struct {
+	Errs   []string `json:"Errs,omitempty"`   // extraction errors, info is partial
+	Handle string   `json:"Handle,omitempty"` // "@handle"
+	Id     string   `json:"Id,omitempty"`     // "UC..."
+	Title  string   `json:"Title,omitempty"`
+	Url    string   `json:"Url,omitempty"`
+}
+
+type Playlist struct {
+	Errs  []string `json:"Errs,omitempty"` // extraction errors, info is partial
+	Id    string   `json:"Id,omitempty"`
+	Title string   `json:"Title,omitempty"`
+	Url   string   `json:"Url,omitempty"`
+}
+
+type Video struct {
+	Channel  Channel  `json:"Channel"`
+	Errs     []string `json:"Errs,omitempty"` // extraction errors, info is partial
+	Id       string   `json:"Id,omitempty"`
+	Members  bool     `json:"Members,omitempty"`  // members only
+	Progress int      `json:"Progress,omitempty"` // watched percentage
+	Section  string   `json:"Section,omitempty"`  // history section title, eg. "Today"
+	Text     string   `json:"Text,omitempty"`     // meta text, eg. "3 hours ago"
+	Title    string   `json:"Title,omitempty"`
+	Type     string   `json:"Type,omitempty"` // subscription video: video, short, live, premiere
+	Url      string   `json:"Url,omitempty"`
+}
+
+func toChannel(info *lib.YT_Info) Channel {
+	ch := Channel{
+		Errs:  info.Errs,
+		Id:    info.ChId,
+		Title: info.ChTitle,
+		Url:   info.ChUrl,
+	}
+	if strings.HasPrefix(info.ChUrlShort, "/@") {
+		ch.Handle = info.ChUrlShort[1:]
+	}
+	return ch
+}
+
+func toPlaylist(info *lib.YT_Info) Playlist {
+	return Playlist{
+		Errs:  info.Errs,
+		Id:    lib.YT_PlaylistId(info.Url),
+		Title: info.Title,
+		Url:   info.Url,
+	}
+}
+
+func toVideo(info *lib.YT_Info) Video {
+	video := Video{
+		Channel:  toChannel(info),
+		Errs:     info.Errs,
+		Id:       lib.YT_VideoId(info.Url),
+		Members:  info.Members,
+		Progress: info.Progress,
+		Section:  info.Section,
+		Text:     info.Text,
+		Title:    info.Title,
+		Type:     info.Type,
+		Url:      info.Url,
+	}
+	video.Channel.Errs = nil // kept in video
+	return video
+}